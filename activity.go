@@ -0,0 +1,257 @@
+package figma
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type activityLogResponse struct {
+	Status int             `json:"status"`
+	Error  bool            `json:"error"`
+	Meta   ActivityLogPage `json:"meta"`
+}
+
+// ActivityLogPage is a page of activity log events. While NextPage is true,
+// pass Cursor in ActivityLogOptions to fetch the next page.
+type ActivityLogPage struct {
+	ActivityLogs []ActivityLog `json:"activity_logs"`
+	NextPage     bool          `json:"next_page"`
+	Cursor       string        `json:"cursor"`
+}
+
+// ActivityLogOrder specifies the order events are returned in.
+type ActivityLogOrder string
+
+const (
+	ActivityLogOrderAsc  ActivityLogOrder = "asc"
+	ActivityLogOrderDesc ActivityLogOrder = "desc"
+)
+
+// ActivityLogOptions narrows down the events returned from the activity log.
+type ActivityLogOptions struct {
+	// Event types to include, e.g. "fig_file.create". Defaults to all events.
+	Events []string
+
+	// Only include events at or after this time.
+	StartTime time.Time
+
+	// Only include events at or before this time. Defaults to now.
+	EndTime time.Time
+
+	// Maximum number of events per page, at most 1000.
+	Limit int
+
+	// Order of the events by timestamp. Defaults to ascending.
+	Order ActivityLogOrder
+
+	// Cursor returned by a previous page, used to resume from that page.
+	Cursor string
+}
+
+func (o ActivityLogOptions) values() url.Values {
+	v := url.Values{}
+	if len(o.Events) > 0 {
+		v.Add("events", strings.Join(o.Events, ","))
+	}
+	if !o.StartTime.IsZero() {
+		v.Add("start_time", strconv.FormatInt(o.StartTime.Unix(), 10))
+	}
+	if !o.EndTime.IsZero() {
+		v.Add("end_time", strconv.FormatInt(o.EndTime.Unix(), 10))
+	}
+	if o.Limit > 0 {
+		v.Add("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Order != "" {
+		v.Add("order", string(o.Order))
+	}
+	if o.Cursor != "" {
+		v.Add("cursor", o.Cursor)
+	}
+	return v
+}
+
+// UnixTime is a point in time encoded as seconds since the Unix epoch.
+type UnixTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (u *UnixTime) UnmarshalJSON(b []byte) error {
+	var s int64
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	u.Time = time.Unix(s, 0).UTC()
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (u UnixTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.Unix())
+}
+
+// ActivityLog is an event recorded in an organization's activity log.
+type ActivityLog struct {
+	// Unique identifier for the event
+	ID string `json:"id"`
+
+	// The time at which the event occurred
+	Timestamp UnixTime `json:"timestamp"`
+
+	// The user who performed the action
+	Actor ActivityLogActor `json:"actor"`
+
+	// The task or activity the actor performed
+	Action ActivityLogAction `json:"action"`
+
+	// The resource the actor took the action on
+	Entity ActivityLogEntity `json:"entity"`
+
+	// Contextual information about the event
+	Context ActivityLogContext `json:"context"`
+}
+
+// ActivityLogActor is the user who performed an action.
+type ActivityLogActor struct {
+	// The type of the actor, currently always "user"
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ActivityLogAction describes the action taken.
+type ActivityLogAction struct {
+	// The type of the action, e.g. "fig_file.create"
+	Type string `json:"type"`
+
+	// Metadata of the action, the keys depend on the action type
+	Details map[string]interface{} `json:"details"`
+}
+
+// ActivityLogEntityType specifies the kind of resource an action was taken on.
+type ActivityLogEntityType string
+
+const (
+	ActivityLogEntityTypeUser      ActivityLogEntityType = "user"
+	ActivityLogEntityTypeTeam      ActivityLogEntityType = "team"
+	ActivityLogEntityTypeWorkspace ActivityLogEntityType = "workspace"
+	ActivityLogEntityTypeOrg       ActivityLogEntityType = "org"
+	ActivityLogEntityTypeProject   ActivityLogEntityType = "project"
+	ActivityLogEntityTypeFile      ActivityLogEntityType = "file"
+	ActivityLogEntityTypeFileRepo  ActivityLogEntityType = "file_repo"
+)
+
+// ActivityLogEntity is the resource an action was taken on. Which fields are
+// set depends on the entity type.
+type ActivityLogEntity struct {
+	Type ActivityLogEntityType `json:"type"`
+
+	// Set for every entity type except files
+	ID string `json:"id"`
+
+	// Set for files
+	Key string `json:"key"`
+
+	Name string `json:"name"`
+
+	// Set for users
+	Email string `json:"email"`
+
+	// Set for files: the editor type and link access of the file
+	EditorType      EditorType `json:"editor_type"`
+	LinkAccess      LinkAccess `json:"link_access"`
+	ProtoLinkAccess string     `json:"proto_link_access"`
+}
+
+// ActivityLogContext describes the circumstances of an action.
+type ActivityLogContext struct {
+	// The third-party application that triggered the event, if applicable
+	ClientName string `json:"client_name"`
+
+	// The IP address from which the event was triggered
+	IPAddress string `json:"ip_address"`
+
+	// Whether the action was performed by Figma support
+	IsFigmaSupportTeamAction bool `json:"is_figma_support_team_action"`
+
+	// The organization and team the event belongs to
+	OrgID  string `json:"org_id"`
+	TeamID string `json:"team_id"`
+}
+
+// ActivityLogIterator walks the pages of an activity log. Each call to Next
+// fetches the following page.
+//
+//	it := c.ActivityLogIterator(opts)
+//	for it.Next() {
+//		export(it.Logs())
+//		store(it.Cursor())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// A stored cursor can be passed as ActivityLogOptions.Cursor to resume
+// exporting after the last page processed.
+type ActivityLogIterator struct {
+	c    *Client
+	opts ActivityLogOptions
+	page ActivityLogPage
+	done bool
+	err  error
+}
+
+// ActivityLogIterator returns an iterator over the pages of the activity log
+// matching opts, starting at opts.Cursor if set.
+func (c *Client) ActivityLogIterator(opts ActivityLogOptions) *ActivityLogIterator {
+	return &ActivityLogIterator{c: c, opts: opts}
+}
+
+// Next fetches the next page and reports whether it succeeded. A page which
+// has more results but no cursor to fetch them is returned, after which the
+// iteration stops and Err reports the missing cursor.
+func (it *ActivityLogIterator) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	page, err := it.c.ActivityLogs(it.opts)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = page
+	if page.Cursor != "" {
+		it.opts.Cursor = page.Cursor
+	}
+	it.done = !page.NextPage
+
+	// Fetching again with the previous cursor would return the same page
+	// forever, so the page is returned and the iteration stops with an error.
+	if page.NextPage && page.Cursor == "" {
+		it.err = errors.New("activity log page has more results but no cursor")
+	}
+	return true
+}
+
+// Logs returns the events of the current page.
+func (it *ActivityLogIterator) Logs() []ActivityLog {
+	return it.page.ActivityLogs
+}
+
+// Cursor returns the cursor pointing after the current page.
+func (it *ActivityLogIterator) Cursor() string {
+	return it.opts.Cursor
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ActivityLogIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,270 @@
+package figma
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// AnalyticsGroupBy specifies the dimension library analytics rows are
+// grouped by.
+type AnalyticsGroupBy string
+
+const (
+	// AnalyticsGroupByComponent groups component data by component.
+	AnalyticsGroupByComponent AnalyticsGroupBy = "component"
+
+	// AnalyticsGroupByStyle groups style data by style.
+	AnalyticsGroupByStyle AnalyticsGroupBy = "style"
+
+	// AnalyticsGroupByVariable groups variable data by variable.
+	AnalyticsGroupByVariable AnalyticsGroupBy = "variable"
+
+	// AnalyticsGroupByTeam groups action data by the team performing them.
+	AnalyticsGroupByTeam AnalyticsGroupBy = "team"
+
+	// AnalyticsGroupByFile groups usage data by the file using the library.
+	AnalyticsGroupByFile AnalyticsGroupBy = "file"
+)
+
+// AnalyticsOptions narrows down a library analytics request.
+type AnalyticsOptions struct {
+	// The dimension to group rows by. Required.
+	GroupBy AnalyticsGroupBy
+
+	// Earliest week to include, rounded back to the nearest start of a week.
+	// Only used by action endpoints. Defaults to one year prior.
+	StartDate time.Time
+
+	// Latest week to include, rounded forward to the nearest end of a week.
+	// Only used by action endpoints. Defaults to the latest computed week.
+	EndDate time.Time
+
+	// Cursor returned by a previous page, used to fetch the next page.
+	Cursor string
+}
+
+func (o AnalyticsOptions) values() url.Values {
+	v := url.Values{}
+	v.Add("group_by", string(o.GroupBy))
+	if !o.StartDate.IsZero() {
+		v.Add("start_date", o.StartDate.Format(dateLayout))
+	}
+	if !o.EndDate.IsZero() {
+		v.Add("end_date", o.EndDate.Format(dateLayout))
+	}
+	if o.Cursor != "" {
+		v.Add("cursor", o.Cursor)
+	}
+	return v
+}
+
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date encoded as YYYY-MM-DD.
+type Date struct {
+	time.Time
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return json.Marshal("")
+	}
+	return json.Marshal(d.Format(dateLayout))
+}
+
+// ComponentActions is a row of weekly insertions and detachments of library
+// components. Component fields are set when grouped by component, team fields
+// when grouped by team.
+type ComponentActions struct {
+	// The start of the week the actions were counted in
+	Week Date `json:"week"`
+
+	ComponentKey     string `json:"component_key"`
+	ComponentName    string `json:"component_name"`
+	ComponentSetKey  string `json:"component_set_key"`
+	ComponentSetName string `json:"component_set_name"`
+
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of times a component was detached
+	Detachments int `json:"detachments"`
+
+	// Number of times a component was inserted
+	Insertions int `json:"insertions"`
+}
+
+// ComponentUsages is a row of current usage counts of library components.
+// Component fields are set when grouped by component, file fields when grouped
+// by file.
+type ComponentUsages struct {
+	ComponentKey     string `json:"component_key"`
+	ComponentName    string `json:"component_name"`
+	ComponentSetKey  string `json:"component_set_key"`
+	ComponentSetName string `json:"component_set_name"`
+
+	FileName      string `json:"file_name"`
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of instances of the component
+	Usages int `json:"usages"`
+
+	// Number of teams and files using the component, when grouped by component
+	TeamsUsing int `json:"teams_using"`
+	FilesUsing int `json:"files_using"`
+}
+
+// StyleActions is a row of weekly insertions and detachments of library
+// styles. Style fields are set when grouped by style, team fields when grouped
+// by team.
+type StyleActions struct {
+	// The start of the week the actions were counted in
+	Week Date `json:"week"`
+
+	StyleKey  string    `json:"style_key"`
+	StyleName string    `json:"style_name"`
+	StyleType StyleType `json:"style_type"`
+
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of times a style was detached
+	Detachments int `json:"detachments"`
+
+	// Number of times a style was inserted
+	Insertions int `json:"insertions"`
+}
+
+// StyleUsages is a row of current usage counts of library styles. Style
+// fields are set when grouped by style, file fields when grouped by file.
+type StyleUsages struct {
+	StyleKey  string    `json:"style_key"`
+	StyleName string    `json:"style_name"`
+	StyleType StyleType `json:"style_type"`
+
+	FileName      string `json:"file_name"`
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of nodes using the style
+	Usages int `json:"usages"`
+
+	// Number of teams and files using the style, when grouped by style
+	TeamsUsing int `json:"teams_using"`
+	FilesUsing int `json:"files_using"`
+}
+
+// VariableActions is a row of weekly insertions and detachments of library
+// variables. Variable fields are set when grouped by variable, team fields
+// when grouped by team.
+type VariableActions struct {
+	// The start of the week the actions were counted in
+	Week Date `json:"week"`
+
+	VariableKey    string `json:"variable_key"`
+	VariableName   string `json:"variable_name"`
+	VariableType   string `json:"variable_type"`
+	CollectionKey  string `json:"collection_key"`
+	CollectionName string `json:"collection_name"`
+
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of times a variable was detached
+	Detachments int `json:"detachments"`
+
+	// Number of times a variable was bound
+	Insertions int `json:"insertions"`
+}
+
+// VariableUsages is a row of current usage counts of library variables.
+// Variable fields are set when grouped by variable, file fields when grouped
+// by file.
+type VariableUsages struct {
+	VariableKey    string `json:"variable_key"`
+	VariableName   string `json:"variable_name"`
+	VariableType   string `json:"variable_type"`
+	CollectionKey  string `json:"collection_key"`
+	CollectionName string `json:"collection_name"`
+
+	FileName      string `json:"file_name"`
+	TeamName      string `json:"team_name"`
+	WorkspaceName string `json:"workspace_name"`
+
+	// Number of nodes bound to the variable
+	Usages int `json:"usages"`
+
+	// Number of teams and files using the variable, when grouped by variable
+	TeamsUsing int `json:"teams_using"`
+	FilesUsing int `json:"files_using"`
+}
+
+// ComponentActionsPage is a page of component action rows. While NextPage is
+// true, pass Cursor in AnalyticsOptions to fetch the next page.
+type ComponentActionsPage struct {
+	Rows     []ComponentActions `json:"rows"`
+	NextPage bool               `json:"next_page"`
+	Cursor   string             `json:"cursor"`
+}
+
+// ComponentUsagesPage is a page of component usage rows. While NextPage is
+// true, pass Cursor in AnalyticsOptions to fetch the next page.
+type ComponentUsagesPage struct {
+	Rows     []ComponentUsages `json:"rows"`
+	NextPage bool              `json:"next_page"`
+	Cursor   string            `json:"cursor"`
+}
+
+// StyleActionsPage is a page of style action rows. While NextPage is true,
+// pass Cursor in AnalyticsOptions to fetch the next page.
+type StyleActionsPage struct {
+	Rows     []StyleActions `json:"rows"`
+	NextPage bool           `json:"next_page"`
+	Cursor   string         `json:"cursor"`
+}
+
+// StyleUsagesPage is a page of style usage rows. While NextPage is true, pass
+// Cursor in AnalyticsOptions to fetch the next page.
+type StyleUsagesPage struct {
+	Rows     []StyleUsages `json:"rows"`
+	NextPage bool          `json:"next_page"`
+	Cursor   string        `json:"cursor"`
+}
+
+// VariableActionsPage is a page of variable action rows. While NextPage is
+// true, pass Cursor in AnalyticsOptions to fetch the next page.
+type VariableActionsPage struct {
+	Rows     []VariableActions `json:"rows"`
+	NextPage bool              `json:"next_page"`
+	Cursor   string            `json:"cursor"`
+}
+
+// VariableUsagesPage is a page of variable usage rows. While NextPage is true,
+// pass Cursor in AnalyticsOptions to fetch the next page.
+type VariableUsagesPage struct {
+	Rows     []VariableUsages `json:"rows"`
+	NextPage bool             `json:"next_page"`
+	Cursor   string           `json:"cursor"`
+}
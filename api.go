@@ -35,13 +35,22 @@ func New(token string) *Client {
 
 // File returns the document referred to by key.
 //	key is the file to export from.
+//	opts are optional settings for the request.
 //
 // The file key can be parsed from any Figma file url:
 // https://www.figma.com/file/:key/:title.
-func (c *Client) File(key string) (File, error) {
+func (c *Client) File(key string, opts ...FileOption) (File, error) {
 	var res File
 
+	v := url.Values{}
+	for _, opt := range opts {
+		opt(v)
+	}
+
 	path := fmt.Sprintf("%s/v1/files/%s", apiURL, key)
+	if len(v) > 0 {
+		path += "?" + v.Encode()
+	}
 	if err := get(c.client, c.token, path, &res); err != nil {
 		return res, err
 	}
@@ -49,6 +58,48 @@ func (c *Client) File(key string) (File, error) {
 	return res, nil
 }
 
+// FileNodes returns the nodes referred to by ids in a file, along with the
+// components and styles they use, without downloading the whole document.
+//
+//	key is the file to export from.
+//	ids is a list of node IDs to export.
+//	opts are optional settings for the request.
+func (c *Client) FileNodes(key string, ids []string, opts ...FileOption) (FileNodes, error) {
+	var res FileNodes
+	if len(ids) == 0 {
+		return res, errors.New("must provide at least one node")
+	}
+
+	v := url.Values{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	v.Set("ids", strings.Join(ids, ","))
+
+	path := fmt.Sprintf("%s/v1/files/%s/nodes?%s", apiURL, key, v.Encode())
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// FileMeta returns the metadata of the file referred to by key, without
+// downloading its document. Comparing Version or LastTouchedAt with a previous
+// call is a cheap way to detect changes to a file.
+//
+//	key is the file to retrieve metadata for.
+func (c *Client) FileMeta(key string) (FileMeta, error) {
+	var res fileMetaResponse
+
+	path := fmt.Sprintf("%s/v1/files/%s/meta", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.File, err
+	}
+
+	return res.File, nil
+}
+
 // Images returns a map of URLs for rendered images of the nodes provided.
 //  key is the file to export images from.
 //  format specifies the image output format.
@@ -83,6 +134,22 @@ func (c *Client) Images(key string, scale float64, i ImageFormat, ids ...string)
 	return res.Images, nil
 }
 
+// ImageFills returns download URLs of the images used in image paints of a
+// file, mapped by the ImageRef of the paints. The URLs expire after at most 14
+// days.
+//
+//	key is the file to get the images of.
+func (c *Client) ImageFills(key string) (map[string]string, error) {
+	var res imageFillsResponse
+
+	path := fmt.Sprintf("%s/v1/files/%s/images", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Meta.Images, nil
+}
+
 // FileVersions returns a list of the version history of a file. The version
 // history consists of versions, manually-saved additions to the version history
 // of a file. If the account is not on a paid team, version history is limited
@@ -151,6 +218,242 @@ func (c *Client) TeamProjects(teamID string) ([]TeamProject, error) {
 	return res.Projects, nil
 }
 
+// Component returns the metadata of a published component.
+//
+//	key is the unique identifier of the component.
+func (c *Client) Component(key string) (PublishedComponent, error) {
+	var res publishedComponentResponse
+
+	path := fmt.Sprintf("%s/v1/components/%s", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.Meta, err
+	}
+
+	return res.Meta, nil
+}
+
+// Components returns the metadata of the published components referred to by
+// keys, mapped by key. Duplicate keys are only looked up once.
+func (c *Client) Components(keys ...string) (map[string]PublishedComponent, error) {
+	res := make(map[string]PublishedComponent)
+	for _, k := range uniq(keys) {
+		comp, err := c.Component(k)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = comp
+	}
+
+	return res, nil
+}
+
+// ComponentSet returns the metadata of a published component set.
+//
+//	key is the unique identifier of the component set.
+func (c *Client) ComponentSet(key string) (PublishedComponentSet, error) {
+	var res publishedComponentSetResponse
+
+	path := fmt.Sprintf("%s/v1/component_sets/%s", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.Meta, err
+	}
+
+	return res.Meta, nil
+}
+
+// ComponentSets returns the metadata of the published component sets referred
+// to by keys, mapped by key. Duplicate keys are only looked up once.
+func (c *Client) ComponentSets(keys ...string) (map[string]PublishedComponentSet, error) {
+	res := make(map[string]PublishedComponentSet)
+	for _, k := range uniq(keys) {
+		set, err := c.ComponentSet(k)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = set
+	}
+
+	return res, nil
+}
+
+// Style returns the metadata of a published style.
+//
+//	key is the unique identifier of the style.
+func (c *Client) Style(key string) (PublishedStyle, error) {
+	var res publishedStyleResponse
+
+	path := fmt.Sprintf("%s/v1/styles/%s", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.Meta, err
+	}
+
+	return res.Meta, nil
+}
+
+// Styles returns the metadata of the published styles referred to by keys,
+// mapped by key. Duplicate keys are only looked up once.
+func (c *Client) Styles(keys ...string) (map[string]PublishedStyle, error) {
+	res := make(map[string]PublishedStyle)
+	for _, k := range uniq(keys) {
+		style, err := c.Style(k)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = style
+	}
+
+	return res, nil
+}
+
+// ProjectFiles lists the files in a specified project, including the
+// branches of each file.
+//
+//	projectID is the id of the project to list files from
+func (c *Client) ProjectFiles(projectID string) ([]ProjectFile, error) {
+	var res projectFilesResponse
+
+	path := fmt.Sprintf("%s/v1/projects/%s/files?branch_data=true", apiURL, projectID)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Files, nil
+}
+
+// FileOption configures optional parameters of a File request.
+type FileOption func(url.Values)
+
+// WithBranchData requests the metadata of the file's branches.
+func WithBranchData() FileOption {
+	return func(v url.Values) {
+		v.Set("branch_data", "true")
+	}
+}
+
+// WithGeometryPaths requests the vector outlines of the fills and strokes of
+// nodes, see GeometryTraits.FillGeometry.
+func WithGeometryPaths() FileOption {
+	return func(v url.Values) {
+		v.Set("geometry", "paths")
+	}
+}
+
+// WithPluginData requests the data stored on nodes by the plugins with the
+// given IDs. Pass "shared" to request the data shared by plugins under
+// namespaces.
+func WithPluginData(pluginID string, more ...string) FileOption {
+	return func(v url.Values) {
+		v.Set("plugin_data", strings.Join(append([]string{pluginID}, more...), ","))
+	}
+}
+
+// LibraryComponentActions returns weekly insertions and detachments of the
+// components published from a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByComponent or AnalyticsGroupByTeam.
+func (c *Client) LibraryComponentActions(key string, opts AnalyticsOptions) (ComponentActionsPage, error) {
+	var res ComponentActionsPage
+	err := c.libraryAnalytics(key, "component/actions", opts, &res)
+	return res, err
+}
+
+// LibraryComponentUsages returns current usage counts of the components
+// published from a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByComponent or AnalyticsGroupByFile.
+func (c *Client) LibraryComponentUsages(key string, opts AnalyticsOptions) (ComponentUsagesPage, error) {
+	var res ComponentUsagesPage
+	err := c.libraryAnalytics(key, "component/usages", opts, &res)
+	return res, err
+}
+
+// LibraryStyleActions returns weekly insertions and detachments of the styles
+// published from a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByStyle or AnalyticsGroupByTeam.
+func (c *Client) LibraryStyleActions(key string, opts AnalyticsOptions) (StyleActionsPage, error) {
+	var res StyleActionsPage
+	err := c.libraryAnalytics(key, "style/actions", opts, &res)
+	return res, err
+}
+
+// LibraryStyleUsages returns current usage counts of the styles published from
+// a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByStyle or AnalyticsGroupByFile.
+func (c *Client) LibraryStyleUsages(key string, opts AnalyticsOptions) (StyleUsagesPage, error) {
+	var res StyleUsagesPage
+	err := c.libraryAnalytics(key, "style/usages", opts, &res)
+	return res, err
+}
+
+// LibraryVariableActions returns weekly insertions and detachments of the
+// variables published from a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByVariable or AnalyticsGroupByTeam.
+func (c *Client) LibraryVariableActions(key string, opts AnalyticsOptions) (VariableActionsPage, error) {
+	var res VariableActionsPage
+	err := c.libraryAnalytics(key, "variable/actions", opts, &res)
+	return res, err
+}
+
+// LibraryVariableUsages returns current usage counts of the variables
+// published from a library file.
+//
+//	key is the library file to retrieve analytics for.
+//	opts.GroupBy must be AnalyticsGroupByVariable or AnalyticsGroupByFile.
+func (c *Client) LibraryVariableUsages(key string, opts AnalyticsOptions) (VariableUsagesPage, error) {
+	var res VariableUsagesPage
+	err := c.libraryAnalytics(key, "variable/usages", opts, &res)
+	return res, err
+}
+
+func (c *Client) libraryAnalytics(key, resource string, opts AnalyticsOptions, res interface{}) error {
+	if opts.GroupBy == "" {
+		return errors.New("must provide a group by dimension")
+	}
+
+	path := fmt.Sprintf("%s/v1/analytics/libraries/%s/%s?%s", apiURL, key, resource, opts.values().Encode())
+	return get(c.client, c.token, path, res)
+}
+
+// ActivityLogs returns a page of events from the activity log of the
+// organization the token belongs to. The token must belong to an admin of an
+// Enterprise organization.
+//
+//	opts filters the events by type and time range, and selects the page.
+func (c *Client) ActivityLogs(opts ActivityLogOptions) (ActivityLogPage, error) {
+	var res activityLogResponse
+
+	path := fmt.Sprintf("%s/v1/activity_logs?%s", apiURL, opts.values().Encode())
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.Meta, err
+	}
+
+	return res.Meta, nil
+}
+
+// LocalVariables returns the variables and variable collections created in a
+// file, including the library variables used in it. The token must belong to a
+// full member of an Enterprise organization.
+//
+//	key is the file to get the variables of.
+func (c *Client) LocalVariables(key string) (LocalVariables, error) {
+	var res localVariablesResponse
+
+	path := fmt.Sprintf("%s/v1/files/%s/variables/local", apiURL, key)
+	if err := get(c.client, c.token, path, &res); err != nil {
+		return res.Meta, err
+	}
+
+	return res.Meta, nil
+}
+
 func get(c *http.Client, token, url string, res interface{}) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
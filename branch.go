@@ -0,0 +1,72 @@
+package figma
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Branch is a branch of a Figma file.
+//
+// A branch is a file of its own: its key can be used wherever a file key is
+// accepted, e.g. to fetch (File), render (Images) or comment on (AddComment)
+// the branch.
+type Branch struct {
+	// The key of the branch file
+	Key string `json:"key"`
+
+	// The name of the branch
+	Name string `json:"name"`
+
+	// URL of a thumbnail image of the branch
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The time at which the branch was last modified
+	LastModified time.Time `json:"last_modified"`
+
+	// The access level of the branch's share link
+	LinkAccess LinkAccess `json:"link_access"`
+}
+
+// IsBranch reports whether the file is a branch of another file.
+func (f File) IsBranch() bool {
+	return f.MainFileKey != ""
+}
+
+// Branch returns the branch of the file with the given name. The file must
+// have been requested with WithBranchData.
+func (f File) Branch(name string) (Branch, bool) {
+	for _, b := range f.Branches {
+		if b.Name == name {
+			return b, true
+		}
+	}
+	return Branch{}, false
+}
+
+// ParseURL returns the file key, and the branch key if any, from a Figma file
+// URL such as https://www.figma.com/file/:key/branch/:branch_key/:title.
+func ParseURL(rawurl string) (key, branchKey string, err error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", "", err
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[1] == "" {
+		return "", "", errors.New("url does not refer to a file")
+	}
+
+	switch parts[0] {
+	case "file", "design", "board", "proto":
+	default:
+		return "", "", errors.New("url does not refer to a file")
+	}
+
+	key = parts[1]
+	if len(parts) >= 4 && parts[2] == "branch" {
+		branchKey = parts[3]
+	}
+	return key, branchKey, nil
+}
@@ -0,0 +1,411 @@
+package figma
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ColorModel converts any color.Color to a Color.
+var ColorModel = color.ModelFunc(func(c color.Color) color.Color {
+	if c, ok := c.(Color); ok {
+		return c
+	}
+
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return Color{}
+	}
+	return Color{
+		Red:   float64(r) / float64(a),
+		Green: float64(g) / float64(a),
+		Blue:  float64(b) / float64(a),
+		Alpha: float64(a) / 0xffff,
+	}
+})
+
+// RGBA implements the color.Color interface, so colors can be used with the
+// image packages of the standard library.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	alpha := clamp01(c.Alpha)
+	scale := func(v float64) uint32 {
+		return uint32(clamp01(v)*alpha*0xffff + 0.5)
+	}
+	return scale(c.Red), scale(c.Green), scale(c.Blue), uint32(alpha*0xffff + 0.5)
+}
+
+// Hex returns the color in hexadecimal notation, #RRGGBB for opaque colors and
+// #RRGGBBAA otherwise.
+func (c Color) Hex() string {
+	s := fmt.Sprintf("#%02X%02X%02X", to8(c.Red), to8(c.Green), to8(c.Blue))
+	if a := to8(c.Alpha); a != 0xff {
+		s += fmt.Sprintf("%02X", a)
+	}
+	return s
+}
+
+// ParseHex parses a color in hexadecimal notation: #RRGGBB or #RRGGBBAA, or
+// the short forms #RGB and #RGBA. The leading # is optional.
+func ParseHex(s string) (Color, error) {
+	h := strings.TrimPrefix(s, "#")
+	if len(h) == 3 || len(h) == 4 {
+		var b strings.Builder
+		for _, r := range h {
+			b.WriteRune(r)
+			b.WriteRune(r)
+		}
+		h = b.String()
+	}
+	if len(h) == 6 {
+		h += "ff"
+	}
+	if len(h) != 8 {
+		return Color{}, fmt.Errorf("invalid hex color %q", s)
+	}
+
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid hex color %q", s)
+	}
+	return Color{
+		Red:   float64(v>>24&0xff) / 255,
+		Green: float64(v>>16&0xff) / 255,
+		Blue:  float64(v>>8&0xff) / 255,
+		Alpha: float64(v&0xff) / 255,
+	}, nil
+}
+
+// CSS returns the color as a CSS rgba() function, e.g. rgba(255, 0, 0, 0.5).
+func (c Color) CSS() string {
+	a := math.Round(clamp01(c.Alpha)*1000) / 1000
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", to8(c.Red), to8(c.Green), to8(c.Blue), strconv.FormatFloat(a, 'f', -1, 64))
+}
+
+// ParseCSS parses a color written as a CSS rgb() or rgba() function with
+// comma separated components, e.g. rgba(255, 0, 0, 0.5). The alpha may also be
+// given as a percentage.
+func ParseCSS(s string) (Color, error) {
+	t := strings.TrimSpace(s)
+	var args string
+	switch {
+	case strings.HasPrefix(t, "rgba(") && strings.HasSuffix(t, ")"):
+		args = t[len("rgba(") : len(t)-1]
+	case strings.HasPrefix(t, "rgb(") && strings.HasSuffix(t, ")"):
+		args = t[len("rgb(") : len(t)-1]
+	default:
+		return Color{}, fmt.Errorf("invalid CSS color %q", s)
+	}
+
+	parts := strings.Split(args, ",")
+	if len(parts) != 3 && len(parts) != 4 {
+		return Color{}, fmt.Errorf("invalid CSS color %q", s)
+	}
+
+	var v [4]float64
+	v[3] = 1
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		pct := strings.HasSuffix(p, "%")
+		f, err := strconv.ParseFloat(strings.TrimSuffix(p, "%"), 64)
+		if err != nil {
+			return Color{}, fmt.Errorf("invalid CSS color %q: %s", s, err)
+		}
+		switch {
+		case pct:
+			f /= 100
+		case i < 3:
+			f /= 255
+		}
+		v[i] = clamp01(f)
+	}
+
+	return Color{Red: v[0], Green: v[1], Blue: v[2], Alpha: v[3]}, nil
+}
+
+// HSL returns the hue in degrees, and the saturation and lightness between 0
+// and 1 of the color.
+func (c Color) HSL() (h, s, l float64) {
+	max, min := c.maxMin()
+	l = (max + min) / 2
+	if d := max - min; d > 0 {
+		s = d / (1 - math.Abs(2*l-1))
+	}
+	return c.hue(), s, l
+}
+
+// ColorFromHSL returns the color with the hue h in degrees, saturation s and
+// lightness l between 0 and 1, and alpha a.
+func ColorFromHSL(h, s, l, a float64) Color {
+	ch := (1 - math.Abs(2*l-1)) * s
+	return fromHueChroma(h, ch, l-ch/2, a)
+}
+
+// HSV returns the hue in degrees, and the saturation and value between 0 and
+// 1 of the color.
+func (c Color) HSV() (h, s, v float64) {
+	max, min := c.maxMin()
+	if max > 0 {
+		s = (max - min) / max
+	}
+	return c.hue(), s, max
+}
+
+// ColorFromHSV returns the color with the hue h in degrees, saturation s and
+// value v between 0 and 1, and alpha a.
+func ColorFromHSV(h, s, v, a float64) Color {
+	ch := v * s
+	return fromHueChroma(h, ch, v-ch, a)
+}
+
+func (c Color) maxMin() (max, min float64) {
+	max = math.Max(c.Red, math.Max(c.Green, c.Blue))
+	min = math.Min(c.Red, math.Min(c.Green, c.Blue))
+	return max, min
+}
+
+func (c Color) hue() float64 {
+	max, min := c.maxMin()
+	d := max - min
+	if d == 0 {
+		return 0
+	}
+
+	var h float64
+	switch max {
+	case c.Red:
+		h = math.Mod((c.Green-c.Blue)/d, 6)
+	case c.Green:
+		h = (c.Blue-c.Red)/d + 2
+	default:
+		h = (c.Red-c.Green)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func fromHueChroma(h, ch, m, a float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	hp := h / 60
+	x := ch * (1 - math.Abs(math.Mod(hp, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case hp < 1:
+		r, g, b = ch, x, 0
+	case hp < 2:
+		r, g, b = x, ch, 0
+	case hp < 3:
+		r, g, b = 0, ch, x
+	case hp < 4:
+		r, g, b = 0, x, ch
+	case hp < 5:
+		r, g, b = x, 0, ch
+	default:
+		r, g, b = ch, 0, x
+	}
+	return Color{Red: r + m, Green: g + m, Blue: b + m, Alpha: a}
+}
+
+// OKLCH returns the color in the OKLCH color space: the perceptual lightness
+// between 0 and 1, the chroma and the hue in degrees.
+func (c Color) OKLCH() (l, ch, h float64) {
+	r, g, b := linear(c.Red), linear(c.Green), linear(c.Blue)
+
+	lc := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	mc := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	sc := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	l = 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc
+	A := 1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc
+	B := 0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+
+	return l, math.Hypot(A, B), degrees(math.Atan2(B, A))
+}
+
+// ColorFromOKLCH returns the color with the lightness l, chroma ch and hue h
+// in degrees in the OKLCH color space, and alpha a. Colors outside of the sRGB
+// gamut are clipped.
+func ColorFromOKLCH(l, ch, h, a float64) Color {
+	A := ch * math.Cos(h*math.Pi/180)
+	B := ch * math.Sin(h*math.Pi/180)
+
+	lc := l + 0.3963377774*A + 0.2158037573*B
+	mc := l - 0.1055613458*A - 0.0638541728*B
+	sc := l - 0.0894841775*A - 1.2914855480*B
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+
+	return Color{
+		Red:   clamp01(gamma(4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc)),
+		Green: clamp01(gamma(-1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc)),
+		Blue:  clamp01(gamma(-0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc)),
+		Alpha: a,
+	}
+}
+
+// WithOpacity returns the color with its alpha multiplied by each of the
+// opacities, such as the opacity of a paint and of the layer it is applied to.
+func (c Color) WithOpacity(opacities ...float64) Color {
+	for _, o := range opacities {
+		c.Alpha *= o
+	}
+	return c
+}
+
+// Over returns the color composited over the color bg.
+func (c Color) Over(bg Color) Color {
+	a := c.Alpha + bg.Alpha*(1-c.Alpha)
+	if a == 0 {
+		return Color{}
+	}
+	blend := func(fg, bgv float64) float64 {
+		return (fg*c.Alpha + bgv*bg.Alpha*(1-c.Alpha)) / a
+	}
+	return Color{
+		Red:   blend(c.Red, bg.Red),
+		Green: blend(c.Green, bg.Green),
+		Blue:  blend(c.Blue, bg.Blue),
+		Alpha: a,
+	}
+}
+
+// EffectiveColor returns the color of a solid paint as it is rendered on a
+// layer with the given opacity: the alpha of the color is multiplied by the
+// opacity of the paint and of the layer. Hidden paints are fully transparent.
+func (p Paint) EffectiveColor(layerOpacity float64) Color {
+	if !p.Visible {
+		return p.Color.WithOpacity(0)
+	}
+	return p.Color.WithOpacity(p.Opacity, layerOpacity)
+}
+
+// DeltaE returns the perceptual distance between the colors according to the
+// CIEDE2000 formula. A distance below 1 is not perceptible, alpha is ignored.
+func (c Color) DeltaE(o Color) float64 {
+	l1, a1, b1 := c.lab()
+	l2, a2, b2 := o.lab()
+	return ciede2000(l1, a1, b1, l2, a2, b2)
+}
+
+func ciede2000(l1, a1, b1, l2, a2, b2 float64) float64 {
+	const pow25to7 = 6103515625 // 25^7
+
+	cbar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2
+	cbar7 := math.Pow(cbar, 7)
+	g := 0.5 * (1 - math.Sqrt(cbar7/(cbar7+pow25to7)))
+
+	a1p, a2p := (1+g)*a1, (1+g)*a2
+	c1p, c2p := math.Hypot(a1p, b1), math.Hypot(a2p, b2)
+	h1p, h2p := hueAngle(b1, a1p), hueAngle(b2, a2p)
+
+	dLp := l2 - l1
+	dCp := c2p - c1p
+
+	var dhp float64
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		if dhp > 180 {
+			dhp -= 360
+		} else if dhp < -180 {
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(dhp*math.Pi/360)
+
+	lbarp := (l1 + l2) / 2
+	cbarp := (c1p + c2p) / 2
+
+	hbarp := h1p + h2p
+	if c1p*c2p != 0 {
+		switch {
+		case math.Abs(h1p-h2p) <= 180:
+			hbarp /= 2
+		case hbarp < 360:
+			hbarp = (hbarp + 360) / 2
+		default:
+			hbarp = (hbarp - 360) / 2
+		}
+	}
+
+	t := 1 - 0.17*cosDeg(hbarp-30) + 0.24*cosDeg(2*hbarp) + 0.32*cosDeg(3*hbarp+6) - 0.20*cosDeg(4*hbarp-63)
+	dTheta := 30 * math.Exp(-math.Pow((hbarp-275)/25, 2))
+	cbarp7 := math.Pow(cbarp, 7)
+	rc := 2 * math.Sqrt(cbarp7/(cbarp7+pow25to7))
+	sl := 1 + 0.015*math.Pow(lbarp-50, 2)/math.Sqrt(20+math.Pow(lbarp-50, 2))
+	sc := 1 + 0.045*cbarp
+	sh := 1 + 0.015*cbarp*t
+	rt := -math.Sin(2*dTheta*math.Pi/180) * rc
+
+	dl, dc, dh := dLp/sl, dCp/sc, dHp/sh
+	return math.Sqrt(dl*dl + dc*dc + dh*dh + rt*dc*dh)
+}
+
+// lab returns the color in the CIELAB color space under the D65 illuminant.
+func (c Color) lab() (l, a, b float64) {
+	r, g, bl := linear(c.Red), linear(c.Green), linear(c.Blue)
+
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*bl) / 0.95047
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*bl
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*bl) / 1.08883
+
+	f := func(t float64) float64 {
+		const d = 6.0 / 29
+		if t > d*d*d {
+			return math.Cbrt(t)
+		}
+		return t/(3*d*d) + 4.0/29
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// linear converts an sRGB component to linear light.
+func linear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// gamma converts a linear light component to sRGB.
+func gamma(v float64) float64 {
+	if v <= 0.0031308 {
+		return 12.92 * v
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+func hueAngle(b, a float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	return degrees(math.Atan2(b, a))
+}
+
+func degrees(rad float64) float64 {
+	d := rad * 180 / math.Pi
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+func cosDeg(d float64) float64 {
+	return math.Cos(d * math.Pi / 180)
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+func to8(v float64) uint8 {
+	return uint8(clamp01(v)*255 + 0.5)
+}
@@ -0,0 +1,167 @@
+package figma
+
+import (
+	"math"
+	"testing"
+)
+
+// The CIEDE2000 test data of Sharma, Wu and Dalal, "The CIEDE2000
+// Color-Difference Formula: Implementation Notes, Supplementary Test Data, and
+// Mathematical Observations", 2005: two colors in CIELAB and their distance.
+var sharmaPairs = [][7]float64{
+	{50.0000, 2.6772, -79.7751, 50.0000, 0.0000, -82.7485, 2.0425},
+	{50.0000, 3.1571, -77.2803, 50.0000, 0.0000, -82.7485, 2.8615},
+	{50.0000, 2.8361, -74.0200, 50.0000, 0.0000, -82.7485, 3.4412},
+	{50.0000, -1.3802, -84.2814, 50.0000, 0.0000, -82.7485, 1.0000},
+	{50.0000, -1.1848, -84.8006, 50.0000, 0.0000, -82.7485, 1.0000},
+	{50.0000, -0.9009, -85.5211, 50.0000, 0.0000, -82.7485, 1.0000},
+	{50.0000, 0.0000, 0.0000, 50.0000, -1.0000, 2.0000, 2.3669},
+	{50.0000, -1.0000, 2.0000, 50.0000, 0.0000, 0.0000, 2.3669},
+	{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0009, 7.1792},
+	{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0010, 7.1792},
+	{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0011, 7.2195},
+	{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0012, 7.2195},
+	{50.0000, -0.0010, 2.4900, 50.0000, 0.0009, -2.4900, 4.8045},
+	{50.0000, -0.0010, 2.4900, 50.0000, 0.0010, -2.4900, 4.8045},
+	{50.0000, -0.0010, 2.4900, 50.0000, 0.0011, -2.4900, 4.7461},
+	{50.0000, 2.5000, 0.0000, 50.0000, 0.0000, -2.5000, 4.3065},
+	{50.0000, 2.5000, 0.0000, 73.0000, 25.0000, -18.0000, 27.1492},
+	{50.0000, 2.5000, 0.0000, 61.0000, -5.0000, 29.0000, 22.8977},
+	{50.0000, 2.5000, 0.0000, 56.0000, -27.0000, -3.0000, 31.9030},
+	{50.0000, 2.5000, 0.0000, 58.0000, 24.0000, 15.0000, 19.4535},
+	{50.0000, 2.5000, 0.0000, 50.0000, 3.1736, 0.5854, 1.0000},
+	{50.0000, 2.5000, 0.0000, 50.0000, 3.2972, 0.0000, 1.0000},
+	{50.0000, 2.5000, 0.0000, 50.0000, 1.8634, 0.5757, 1.0000},
+	{50.0000, 2.5000, 0.0000, 50.0000, 3.2592, 0.3350, 1.0000},
+	{60.2574, -34.0099, 36.2677, 60.4626, -34.1751, 39.4387, 1.2644},
+	{63.0109, -31.0961, -5.8663, 62.8187, -29.7946, -4.0864, 1.2630},
+	{61.2901, 3.7196, -5.3901, 61.4292, 2.2480, -4.9620, 1.8731},
+	{35.0831, -44.1164, 3.7933, 35.0232, -40.0716, 1.5901, 1.8645},
+	{22.7233, 20.0904, -46.6940, 23.0331, 14.9730, -42.5619, 2.0373},
+	{36.4612, 47.8580, 18.3852, 36.2715, 50.5065, 21.2231, 1.4146},
+	{90.8027, -2.0831, 1.4410, 91.1528, -1.6435, 0.0447, 1.4441},
+	{90.9257, -0.5406, -0.9208, 88.6381, -0.8985, -0.7239, 1.5381},
+	{6.7747, -0.2908, -2.4247, 5.8714, -0.0985, -2.2286, 0.6377},
+	{2.0776, 0.0795, -1.1350, 0.9033, -0.0636, -0.5514, 0.9082},
+}
+
+func TestCIEDE2000(t *testing.T) {
+	for i, p := range sharmaPairs {
+		if d := ciede2000(p[0], p[1], p[2], p[3], p[4], p[5]); math.Abs(d-p[6]) > 5e-5 {
+			t.Errorf("pair %d: ciede2000 = %.4f, want %.4f", i+1, d, p[6])
+		}
+		if d := ciede2000(p[3], p[4], p[5], p[0], p[1], p[2]); math.Abs(d-p[6]) > 5e-5 {
+			t.Errorf("pair %d reversed: ciede2000 = %.4f, want %.4f", i+1, d, p[6])
+		}
+	}
+}
+
+func TestDeltaE(t *testing.T) {
+	red := Color{Red: 1, Alpha: 1}
+	if d := red.DeltaE(red); d != 0 {
+		t.Errorf("distance of a color to itself = %v", d)
+	}
+	if d := red.DeltaE(red.WithOpacity(0.5)); d != 0 {
+		t.Errorf("distance ignoring alpha = %v", d)
+	}
+	// Black and white are 100 apart in lightness, which CIEDE2000 weighs 1:1
+	// at a mean lightness of 50.
+	black, white := Color{Alpha: 1}, Color{Red: 1, Green: 1, Blue: 1, Alpha: 1}
+	if d := black.DeltaE(white); math.Abs(d-100) > 1e-3 {
+		t.Errorf("distance of black and white = %v, want 100", d)
+	}
+}
+
+func TestOKLCH(t *testing.T) {
+	tests := []struct {
+		hex     string
+		l, c, h float64
+	}{
+		{"#FFFFFF", 1, 0, -1},
+		{"#000000", 0, 0, -1},
+		{"#FF0000", 0.62796, 0.25768, 29.234},
+		{"#00FF00", 0.86644, 0.29483, 142.495},
+		{"#0000FF", 0.45201, 0.31321, 264.052},
+	}
+	for _, tt := range tests {
+		c, err := ParseHex(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, ch, h := c.OKLCH()
+		if math.Abs(l-tt.l) > 1e-4 || math.Abs(ch-tt.c) > 1e-4 || (tt.h >= 0 && math.Abs(h-tt.h) > 1e-2) {
+			t.Errorf("%s: OKLCH = %.5f %.5f %.3f, want %.5f %.5f %.3f", tt.hex, l, ch, h, tt.l, tt.c, tt.h)
+		}
+	}
+}
+
+func TestOKLCHRoundTrip(t *testing.T) {
+	for _, hex := range []string{"#FFFFFF", "#000000", "#FF0000", "#00FF00", "#0000FF", "#FF8000", "#112233", "#7F7F7F", "#C0FFEE", "#0D99FF"} {
+		c, err := ParseHex(hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, ch, h := c.OKLCH()
+		if got := ColorFromOKLCH(l, ch, h, 1).Hex(); got != hex {
+			t.Errorf("%s: round trip through OKLCH %.5f %.5f %.3f = %s", hex, l, ch, h, got)
+		}
+	}
+}
+
+func TestHSL(t *testing.T) {
+	tests := []struct {
+		hex     string
+		h, s, l float64
+	}{
+		{"#FF0000", 0, 1, 0.5},
+		{"#FFFF00", 60, 1, 0.5},
+		{"#00FF00", 120, 1, 0.5},
+		{"#0000FF", 240, 1, 0.5},
+		{"#FF00FF", 300, 1, 0.5},
+		{"#000000", 0, 0, 0},
+		{"#FFFFFF", 0, 0, 1},
+		{"#FF8080", 0, 1, 0.75098},
+		{"#400080", 270, 1, 0.25098},
+	}
+	for _, tt := range tests {
+		c, err := ParseHex(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, s, l := c.HSL()
+		if math.Abs(h-tt.h) > 1e-3 || math.Abs(s-tt.s) > 1e-3 || math.Abs(l-tt.l) > 1e-3 {
+			t.Errorf("%s: HSL = %.3f %.3f %.3f, want %.3f %.3f %.3f", tt.hex, h, s, l, tt.h, tt.s, tt.l)
+		}
+		if got := ColorFromHSL(h, s, l, 1).Hex(); got != tt.hex {
+			t.Errorf("%s: round trip through HSL = %s", tt.hex, got)
+		}
+	}
+}
+
+func TestHSV(t *testing.T) {
+	tests := []struct {
+		hex     string
+		h, s, v float64
+	}{
+		{"#FF0000", 0, 1, 1},
+		{"#00FFFF", 180, 1, 1},
+		{"#800000", 0, 1, 0.50196},
+		{"#FF8080", 0, 0.49804, 1},
+		{"#000000", 0, 0, 0},
+		{"#808080", 0, 0, 0.50196},
+		{"#400080", 270, 1, 0.50196},
+	}
+	for _, tt := range tests {
+		c, err := ParseHex(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, s, v := c.HSV()
+		if math.Abs(h-tt.h) > 1e-3 || math.Abs(s-tt.s) > 1e-3 || math.Abs(v-tt.v) > 1e-3 {
+			t.Errorf("%s: HSV = %.3f %.3f %.3f, want %.3f %.3f %.3f", tt.hex, h, s, v, tt.h, tt.s, tt.v)
+		}
+		if got := ColorFromHSV(h, s, v, 1).Hex(); got != tt.hex {
+			t.Errorf("%s: round trip through HSV = %s", tt.hex, got)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package figma
+
+import "fmt"
+
+// ResizeBounds returns the absolute bounds of the frame n and its descendants
+// after resizing n to the given width and height, mapped by node ID. n keeps
+// its position, each descendant is moved and resized according to its layout
+// constraints, as Figma does when a frame is resized. n itself is not changed.
+//
+// Children of groups and boolean operations are constrained relative to the
+// frame containing the group, and the group takes the bounds of its children.
+// Resized descendants keep within their minimum and maximum width and height.
+// Auto layout is not simulated: children of auto layout frames keep their
+// position relative to the frame unless they are absolutely positioned.
+//
+// Bounds are those of AbsoluteBoundingBox, which only follow the constraints
+// of nodes which are not rotated. An error is returned if n or a node whose
+// constraints apply is rotated; rotated nodes which are only moved along with
+// their parent are supported.
+func (n *Node) ResizeBounds(width, height float64) (map[string]Rectangle, error) {
+	if width < 0 || height < 0 {
+		return nil, fmt.Errorf("%s: invalid size %gx%g", n.ID, width, height)
+	}
+	if n.RelativeTransform.rotated() {
+		return nil, fmt.Errorf("%s: cannot resize rotated node", n.ID)
+	}
+
+	old := n.AbsoluteBoundingBox
+	bounds := Rectangle{X: old.X, Y: old.Y, Width: width, Height: height}
+
+	res := map[string]Rectangle{n.ID: bounds}
+	if err := resizeChildren(n, old, bounds, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// resizeChildren computes the bounds of the children of n when its frame is
+// resized from old to bounds.
+func resizeChildren(n *Node, old, bounds Rectangle, res map[string]Rectangle) error {
+	auto := n.LayoutMode != "" && n.LayoutMode != LayoutModeNone
+
+	for i := range n.Children {
+		c := &n.Children[i]
+		cb := c.AbsoluteBoundingBox
+
+		if auto && c.LayoutPositioning != LayoutPositioningAbsolute {
+			// Children laid out by the frame keep their position relative
+			// to it, along with their descendants.
+			translate(n.Children[i:i+1], bounds.X-old.X, bounds.Y-old.Y, res)
+			continue
+		}
+		if c.RelativeTransform.rotated() {
+			return fmt.Errorf("%s: constraints of rotated nodes are not supported", c.ID)
+		}
+
+		var nb Rectangle
+		nb.X, nb.Width = constrain(c.Constraints.Horizontal, cb.X, cb.Width, old.X, old.Width, bounds.X, bounds.Width)
+		nb.Y, nb.Height = constrain(horizontal(c.Constraints.Vertical), cb.Y, cb.Height, old.Y, old.Height, bounds.Y, bounds.Height)
+		if nb.Width != cb.Width {
+			nb.Width = clamp(nb.Width, c.MinWidth, c.MaxWidth)
+		}
+		if nb.Height != cb.Height {
+			nb.Height = clamp(nb.Height, c.MinHeight, c.MaxHeight)
+		}
+
+		switch {
+		case (c.Type == NodeTypeGroup || c.Type == NodeTypeBooleanOperation) && len(c.Children) > 0:
+			// The children of groups are constrained to the enclosing frame.
+			if err := resizeChildren(c, old, bounds, res); err != nil {
+				return err
+			}
+			nb = res[c.Children[0].ID]
+			for _, gc := range c.Children[1:] {
+				nb = nb.Union(res[gc.ID])
+			}
+		case nb.Width != cb.Width || nb.Height != cb.Height:
+			if err := resizeChildren(c, cb, nb, res); err != nil {
+				return err
+			}
+		default:
+			translate(c.Children, nb.X-cb.X, nb.Y-cb.Y, res)
+		}
+
+		res[c.ID] = nb
+	}
+	return nil
+}
+
+// translate moves the bounds of nodes and their descendants by dx and dy.
+func translate(nodes []Node, dx, dy float64, res map[string]Rectangle) {
+	for i := range nodes {
+		b := nodes[i].AbsoluteBoundingBox
+		b.X += dx
+		b.Y += dy
+		res[nodes[i].ID] = b
+		translate(nodes[i].Children, dx, dy, res)
+	}
+}
+
+// constrain returns the position and size along one axis of a node at pos
+// with the given size, inside a parent resized from oldStart and oldSize to
+// newStart and newSize.
+func constrain(c HorizontalLayoutConstraint, pos, size, oldStart, oldSize, newStart, newSize float64) (float64, float64) {
+	offset := pos - oldStart
+	delta := newSize - oldSize
+
+	switch c {
+	case HorizontalLayoutConstraintRight:
+		return newStart + offset + delta, size
+	case HorizontalLayoutConstraintCenter:
+		return newStart + offset + delta/2, size
+	case HorizontalLayoutConstraintLeftRight:
+		if size+delta < 0 {
+			return newStart + offset, 0
+		}
+		return newStart + offset, size + delta
+	case HorizontalLayoutConstraintScale:
+		if oldSize == 0 {
+			return newStart + offset, size
+		}
+		k := newSize / oldSize
+		return newStart + offset*k, size * k
+	default:
+		return newStart + offset, size
+	}
+}
+
+// clamp returns size limited to the optional minimum and maximum.
+func clamp(size float64, min, max *float64) float64 {
+	if max != nil && size > *max {
+		size = *max
+	}
+	if min != nil && size < *min {
+		size = *min
+	}
+	return size
+}
+
+// horizontal returns the horizontal constraint equivalent to the vertical
+// constraint v, so both axes can be resolved alike.
+func horizontal(v VerticalLayoutConstraint) HorizontalLayoutConstraint {
+	switch v {
+	case VerticalLayoutConstraintTop:
+		return HorizontalLayoutConstraintLeft
+	case VerticalLayoutConstraintBottom:
+		return HorizontalLayoutConstraintRight
+	case VerticalLayoutConstraintTopBottom:
+		return HorizontalLayoutConstraintLeftRight
+	default:
+		return HorizontalLayoutConstraint(v)
+	}
+}
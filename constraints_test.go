@@ -0,0 +1,133 @@
+package figma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResizeBounds(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// The properties of the 200x100 frame at 100,100 resized to 400x200,
+		// besides its ID, type and bounds
+		frame string
+		want  map[string]Rectangle
+	}{
+		{
+			name:  "left and top",
+			frame: `"children":[{"id":"c","type":"RECTANGLE","absoluteBoundingBox":{"x":110,"y":110,"width":20,"height":20},"constraints":{"horizontal":"LEFT","vertical":"TOP"}}]`,
+			want:  map[string]Rectangle{"c": {X: 110, Y: 110, Width: 20, Height: 20}},
+		},
+		{
+			name:  "right and bottom",
+			frame: `"children":[{"id":"c","type":"RECTANGLE","absoluteBoundingBox":{"x":260,"y":160,"width":30,"height":20},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"}}]`,
+			want:  map[string]Rectangle{"c": {X: 460, Y: 260, Width: 30, Height: 20}},
+		},
+		{
+			name:  "left right and top bottom",
+			frame: `"children":[{"id":"c","type":"RECTANGLE","absoluteBoundingBox":{"x":110,"y":110,"width":180,"height":80},"constraints":{"horizontal":"LEFT_RIGHT","vertical":"TOP_BOTTOM"}}]`,
+			want:  map[string]Rectangle{"c": {X: 110, Y: 110, Width: 380, Height: 180}},
+		},
+		{
+			name:  "center",
+			frame: `"children":[{"id":"c","type":"RECTANGLE","absoluteBoundingBox":{"x":150,"y":130,"width":100,"height":40},"constraints":{"horizontal":"CENTER","vertical":"CENTER"}}]`,
+			want:  map[string]Rectangle{"c": {X: 250, Y: 180, Width: 100, Height: 40}},
+		},
+		{
+			name:  "scale",
+			frame: `"children":[{"id":"c","type":"RECTANGLE","absoluteBoundingBox":{"x":150,"y":120,"width":50,"height":20},"constraints":{"horizontal":"SCALE","vertical":"SCALE"}}]`,
+			want:  map[string]Rectangle{"c": {X: 200, Y: 140, Width: 100, Height: 40}},
+		},
+		{
+			name: "nested frame",
+			frame: `"children":[{"id":"s","type":"FRAME","absoluteBoundingBox":{"x":100,"y":100,"width":100,"height":50},"constraints":{"horizontal":"SCALE","vertical":"SCALE"},"children":[
+				{"id":"s1","type":"RECTANGLE","absoluteBoundingBox":{"x":150,"y":100,"width":50,"height":50},"constraints":{"horizontal":"LEFT_RIGHT","vertical":"BOTTOM"}}]}]`,
+			want: map[string]Rectangle{
+				"s":  {X: 100, Y: 100, Width: 200, Height: 100},
+				"s1": {X: 150, Y: 150, Width: 150, Height: 50},
+			},
+		},
+		{
+			name: "group",
+			frame: `"children":[{"id":"g","type":"GROUP","absoluteBoundingBox":{"x":110,"y":180,"width":190,"height":10},"constraints":{"horizontal":"LEFT","vertical":"TOP"},"children":[
+				{"id":"g1","type":"RECTANGLE","absoluteBoundingBox":{"x":110,"y":180,"width":10,"height":10},"constraints":{"horizontal":"LEFT","vertical":"BOTTOM"}},
+				{"id":"g2","type":"RECTANGLE","absoluteBoundingBox":{"x":290,"y":180,"width":10,"height":10},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"}}]}]`,
+			want: map[string]Rectangle{
+				"g":  {X: 110, Y: 280, Width: 390, Height: 10},
+				"g1": {X: 110, Y: 280, Width: 10, Height: 10},
+				"g2": {X: 490, Y: 280, Width: 10, Height: 10},
+			},
+		},
+		{
+			name: "auto layout",
+			frame: `"layoutMode":"HORIZONTAL","children":[
+				{"id":"a","type":"RECTANGLE","absoluteBoundingBox":{"x":260,"y":110,"width":30,"height":20},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"}},
+				{"id":"b","type":"RECTANGLE","absoluteBoundingBox":{"x":260,"y":110,"width":30,"height":20},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"},"layoutPositioning":"ABSOLUTE"}]`,
+			want: map[string]Rectangle{
+				"a": {X: 260, Y: 110, Width: 30, Height: 20},
+				"b": {X: 460, Y: 210, Width: 30, Height: 20},
+			},
+		},
+		{
+			name: "group in auto layout",
+			frame: `"layoutMode":"VERTICAL","children":[{"id":"g","type":"GROUP","absoluteBoundingBox":{"x":110,"y":110,"width":180,"height":10},"children":[
+				{"id":"g1","type":"RECTANGLE","absoluteBoundingBox":{"x":110,"y":110,"width":10,"height":10},"constraints":{"horizontal":"LEFT","vertical":"BOTTOM"}},
+				{"id":"g2","type":"RECTANGLE","absoluteBoundingBox":{"x":280,"y":110,"width":10,"height":10},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"}}]}]`,
+			want: map[string]Rectangle{
+				"g":  {X: 110, Y: 110, Width: 180, Height: 10},
+				"g1": {X: 110, Y: 110, Width: 10, Height: 10},
+				"g2": {X: 280, Y: 110, Width: 10, Height: 10},
+			},
+		},
+		{
+			name: "rotated descendant moved with its parent",
+			frame: `"children":[{"id":"m","type":"FRAME","absoluteBoundingBox":{"x":250,"y":150,"width":40,"height":40},"constraints":{"horizontal":"RIGHT","vertical":"BOTTOM"},"children":[
+				{"id":"r","type":"RECTANGLE","absoluteBoundingBox":{"x":255,"y":155,"width":14,"height":14},"relativeTransform":[[0.7071,-0.7071,5],[0.7071,0.7071,5]]}]}]`,
+			want: map[string]Rectangle{
+				"m": {X: 450, Y: 250, Width: 40, Height: 40},
+				"r": {X: 455, Y: 255, Width: 14, Height: 14},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		var n Node
+		data := `{"id":"f","type":"FRAME","absoluteBoundingBox":{"x":100,"y":100,"width":200,"height":100},` + tt.frame + `}`
+		if err := json.Unmarshal([]byte(data), &n); err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+
+		res, err := n.ResizeBounds(400, 200)
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if f := res["f"]; f != (Rectangle{X: 100, Y: 100, Width: 400, Height: 200}) {
+			t.Errorf("%s: frame = %+v", tt.name, f)
+		}
+		for id, want := range tt.want {
+			if got := res[id]; got != want {
+				t.Errorf("%s: %s = %+v, want %+v", tt.name, id, got, want)
+			}
+		}
+	}
+}
+
+func TestResizeBoundsRotated(t *testing.T) {
+	var n Node
+	data := `{"id":"f","type":"FRAME","absoluteBoundingBox":{"x":100,"y":100,"width":200,"height":100},"children":[
+		{"id":"r","type":"RECTANGLE","absoluteBoundingBox":{"x":110,"y":110,"width":14,"height":14},"relativeTransform":[[0.7071,-0.7071,10],[0.7071,0.7071,10]],"constraints":{"horizontal":"SCALE","vertical":"TOP"}}]}`
+	if err := json.Unmarshal([]byte(data), &n); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.ResizeBounds(400, 200); err == nil {
+		t.Error("constraints of rotated child applied")
+	}
+
+	n.RelativeTransform = Transform{{0, -1, 0}, {1, 0, 0}}
+	n.Children = nil
+	if _, err := n.ResizeBounds(400, 200); err == nil {
+		t.Error("rotated frame resized")
+	}
+}
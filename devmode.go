@@ -0,0 +1,192 @@
+package figma
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DevStatusType specifies the development status of a node.
+type DevStatusType string
+
+const (
+	DevStatusNone        DevStatusType = "NONE"
+	DevStatusReadyForDev DevStatusType = "READY_FOR_DEV"
+	DevStatusCompleted   DevStatusType = "COMPLETED"
+)
+
+// DevStatus is the development status of a node in Dev Mode.
+type DevStatus struct {
+	Type DevStatusType `json:"type"`
+
+	// A note on the status, as entered by the designer
+	Description string `json:"description,omitempty"`
+}
+
+// Annotation is a note or a set of pinned properties attached to a node in
+// Dev Mode.
+type Annotation struct {
+	// The text of the annotation
+	Label string `json:"label,omitempty"`
+
+	// The text of the annotation with its Markdown formatting
+	LabelMarkdown string `json:"labelMarkdown,omitempty"`
+
+	// The properties of the node pinned to the annotation
+	Properties []AnnotationProperty `json:"properties,omitempty"`
+
+	// The category of the annotation, if any
+	CategoryID string `json:"categoryId,omitempty"`
+}
+
+// AnnotationProperty is a property of a node pinned to an annotation.
+type AnnotationProperty struct {
+	Type AnnotationPropertyType `json:"type"`
+}
+
+// AnnotationPropertyType specifies the property pinned to an annotation.
+type AnnotationPropertyType string
+
+const (
+	AnnotationPropertyWidth               AnnotationPropertyType = "width"
+	AnnotationPropertyHeight              AnnotationPropertyType = "height"
+	AnnotationPropertyMaxWidth            AnnotationPropertyType = "maxWidth"
+	AnnotationPropertyMinWidth            AnnotationPropertyType = "minWidth"
+	AnnotationPropertyMaxHeight           AnnotationPropertyType = "maxHeight"
+	AnnotationPropertyMinHeight           AnnotationPropertyType = "minHeight"
+	AnnotationPropertyFills               AnnotationPropertyType = "fills"
+	AnnotationPropertyStrokes             AnnotationPropertyType = "strokes"
+	AnnotationPropertyEffects             AnnotationPropertyType = "effects"
+	AnnotationPropertyStrokeWeight        AnnotationPropertyType = "strokeWeight"
+	AnnotationPropertyCornerRadius        AnnotationPropertyType = "cornerRadius"
+	AnnotationPropertyTextStyleID         AnnotationPropertyType = "textStyleId"
+	AnnotationPropertyTextAlignHorizontal AnnotationPropertyType = "textAlignHorizontal"
+	AnnotationPropertyFontFamily          AnnotationPropertyType = "fontFamily"
+	AnnotationPropertyFontStyle           AnnotationPropertyType = "fontStyle"
+	AnnotationPropertyFontSize            AnnotationPropertyType = "fontSize"
+	AnnotationPropertyFontWeight          AnnotationPropertyType = "fontWeight"
+	AnnotationPropertyLineHeight          AnnotationPropertyType = "lineHeight"
+	AnnotationPropertyLetterSpacing       AnnotationPropertyType = "letterSpacing"
+	AnnotationPropertyItemSpacing         AnnotationPropertyType = "itemSpacing"
+	AnnotationPropertyPadding             AnnotationPropertyType = "padding"
+	AnnotationPropertyLayoutMode          AnnotationPropertyType = "layoutMode"
+	AnnotationPropertyAlignItems          AnnotationPropertyType = "alignItems"
+	AnnotationPropertyOpacity             AnnotationPropertyType = "opacity"
+	AnnotationPropertyMainComponent       AnnotationPropertyType = "mainComponent"
+)
+
+// DevItem is a node marked ready for development or completed, as listed in a
+// Dev Mode report.
+type DevItem struct {
+	// The file the node is in
+	FileKey  string
+	FileName string
+
+	// The page the node is on
+	Page string
+
+	// The node marked with the status
+	NodeID   string
+	NodeName string
+	NodeType NodeType
+
+	// The status of the node
+	Status DevStatus
+
+	// The annotations of the node and of its descendants, except those of
+	// descendants marked with a status of their own and their descendants
+	Annotations []NodeAnnotation
+
+	// The time at which the file was last modified. The API does not expose
+	// when each node was last changed.
+	LastModified time.Time
+
+	// Link opening the node in the editor
+	URL string
+}
+
+// NodeAnnotation is an annotation with the node it is attached to.
+type NodeAnnotation struct {
+	NodeID   string
+	NodeName string
+	Annotation
+}
+
+// DevReport lists the nodes of the file marked ready for development or
+// completed, in document order, with their annotations.
+//
+//	key is the key of the file, used to link to the nodes.
+func (f *File) DevReport(key string) []DevItem {
+	var res []DevItem
+	for i := range f.Document.Children {
+		page := &f.Document.Children[i]
+		walk(page, func(n *Node) {
+			if !n.markedForDev() {
+				return
+			}
+
+			item := DevItem{
+				FileKey:      key,
+				FileName:     f.Name,
+				Page:         page.Name,
+				NodeID:       n.ID,
+				NodeName:     n.Name,
+				NodeType:     n.Type,
+				Status:       *n.DevStatus,
+				LastModified: f.LastModified,
+				URL:          NodeURL(key, n.ID),
+			}
+			item.Annotations = annotations(n, nil)
+			res = append(res, item)
+		})
+	}
+	return res
+}
+
+// markedForDev reports whether n is marked ready for development or
+// completed.
+func (n *Node) markedForDev() bool {
+	if n.DevStatus == nil {
+		return false
+	}
+	t := n.DevStatus.Type
+	return t == DevStatusReadyForDev || t == DevStatusCompleted
+}
+
+// annotations appends the annotations of n and its descendants to res. The
+// descendants marked for development are skipped along with their own
+// descendants, as they are listed as items of their own.
+func annotations(n *Node, res []NodeAnnotation) []NodeAnnotation {
+	for _, a := range n.Annotations {
+		res = append(res, NodeAnnotation{NodeID: n.ID, NodeName: n.Name, Annotation: a})
+	}
+	for i := range n.Children {
+		if c := &n.Children[i]; !c.markedForDev() {
+			res = annotations(c, res)
+		}
+	}
+	return res
+}
+
+// DevReport fetches the files referred to by keys and lists their nodes
+// marked ready for development or completed, see File.DevReport. Duplicate
+// keys are only fetched once.
+func (c *Client) DevReport(keys ...string) ([]DevItem, error) {
+	var res []DevItem
+	for _, k := range uniq(keys) {
+		f, err := c.File(k)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, f.DevReport(k)...)
+	}
+
+	return res, nil
+}
+
+// NodeURL returns the link opening the node with the given ID in the file
+// referred to by key, e.g. https://www.figma.com/design/:key/?node-id=1-2.
+func NodeURL(key, nodeID string) string {
+	id := strings.Replace(nodeID, ":", "-", -1)
+	return "https://www.figma.com/design/" + url.PathEscape(key) + "/?node-id=" + url.QueryEscape(id)
+}
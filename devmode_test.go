@@ -0,0 +1,51 @@
+package figma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const devFixture = `{"name":"App","lastModified":"2024-05-01T10:00:00Z","document":{"id":"0:0","type":"DOCUMENT","children":[{"id":"0:1","name":"Page","type":"CANVAS","children":[
+	{"id":"1:2","name":"Login","type":"FRAME","devStatus":{"type":"READY_FOR_DEV"},"annotations":[{"label":"Use SSO"}],"children":[
+		{"id":"1:3","name":"Button","type":"INSTANCE","annotations":[{"labelMarkdown":"**Primary**"}]},
+		{"id":"1:4","name":"Form","type":"FRAME","devStatus":{"type":"COMPLETED"},"annotations":[{"label":"Validate on blur"}],"children":[
+			{"id":"1:5","name":"Field","type":"INSTANCE","annotations":[{"label":"Email only"}]}]},
+		{"id":"1:6","name":"Footer","type":"FRAME","devStatus":{"type":"NONE"},"annotations":[{"label":"Links"}]}]},
+	{"id":"2:2","name":"Old","type":"SECTION","devStatus":{"type":"NONE"}}]}]}}`
+
+func TestDevReport(t *testing.T) {
+	var f File
+	if err := json.Unmarshal([]byte(devFixture), &f); err != nil {
+		t.Fatal(err)
+	}
+
+	items := f.DevReport("abc")
+	if len(items) != 2 || items[0].NodeID != "1:2" || items[1].NodeID != "1:4" {
+		t.Fatalf("items = %+v, want 1:2 and 1:4", items)
+	}
+
+	want := map[string][]string{
+		"1:2": {"1:2", "1:3", "1:6"},
+		"1:4": {"1:4", "1:5"},
+	}
+	for _, it := range items {
+		var got []string
+		for _, a := range it.Annotations {
+			got = append(got, a.NodeID)
+		}
+		if len(got) != len(want[it.NodeID]) {
+			t.Errorf("%s: annotations of %v, want %v", it.NodeID, got, want[it.NodeID])
+			continue
+		}
+		for i := range got {
+			if got[i] != want[it.NodeID][i] {
+				t.Errorf("%s: annotations of %v, want %v", it.NodeID, got, want[it.NodeID])
+				break
+			}
+		}
+	}
+
+	if u := items[0].URL; u != "https://www.figma.com/design/abc/?node-id=1-2" {
+		t.Errorf("URL = %s", u)
+	}
+}
@@ -11,11 +11,24 @@ import (
 func main() {
 	at := flag.String("access-token", "", "personal access token from Figma")
 	key := flag.String("key", "", "key to Figma file")
+	link := flag.String("url", "", "link to Figma file or branch, instead of key")
 	msg := flag.String("message", "", "the text to add as comment")
 	x := flag.Float64("x", 0, "the X position where the comment should be added")
 	y := flag.Float64("y", 0, "the Y position where the comment should be added")
 	flag.Parse()
 
+	if *link != "" {
+		k, branch, err := figma.ParseURL(*link)
+		if err != nil {
+			log.Fatal(err)
+		}
+		*key = k
+		if branch != "" {
+			// Branches are commented on like files, by their own key.
+			*key = branch
+		}
+	}
+
 	if *at == "" || *key == "" {
 		flag.Usage()
 		os.Exit(-1)
@@ -23,7 +36,7 @@ func main() {
 
 	c := figma.New(*at)
 
-	comment, err := c.AddComment(*key, *msg, figma.Vector{*x, *y})
+	comment, err := c.AddComment(*key, *msg, figma.Vector{X: *x, Y: *y})
 	if err != nil {
 		log.Println(err)
 	}
@@ -0,0 +1,140 @@
+package figjam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/torie/figma"
+)
+
+// DOT returns the diagram as a Graphviz DOT graph. Sections become clusters,
+// and nodes are filled with their color on the board.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+
+	dir := g.Direction
+	if dir == "" {
+		dir = DirectionTopToBottom
+	}
+	b.WriteString("digraph {\n")
+	fmt.Fprintf(&b, "\trankdir=%s;\n", dir)
+
+	nodes := make(map[string]Node, len(g.Nodes))
+	grouped := make(map[string]bool)
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+
+	var group func(gr *Group, indent string)
+	group = func(gr *Group, indent string) {
+		fmt.Fprintf(&b, "%ssubgraph %s {\n", indent, dotString("cluster_"+gr.ID))
+		fmt.Fprintf(&b, "%s\tlabel=%s;\n", indent, dotString(gr.Name))
+		for _, id := range gr.NodeIDs {
+			grouped[id] = true
+			fmt.Fprintf(&b, "%s\t%s\n", indent, dotNode(nodes[id]))
+		}
+		for _, s := range gr.Groups {
+			group(s, indent+"\t")
+		}
+		fmt.Fprintf(&b, "%s}\n", indent)
+	}
+	for _, gr := range g.Groups {
+		group(gr, "\t")
+	}
+
+	for _, n := range g.Nodes {
+		if !grouped[n.ID] {
+			fmt.Fprintf(&b, "\t%s\n", dotNode(n))
+		}
+	}
+
+	for _, e := range g.Edges {
+		attrs := []string{}
+		if e.Label != "" {
+			attrs = append(attrs, "label="+dotString(e.Label))
+		}
+		switch {
+		case e.StartArrow && e.EndArrow:
+			attrs = append(attrs, "dir=both")
+		case e.StartArrow:
+			attrs = append(attrs, "dir=back")
+		case !e.EndArrow:
+			attrs = append(attrs, "dir=none")
+		}
+
+		fmt.Fprintf(&b, "\t%s -> %s", dotString(e.From), dotString(e.To))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotNode returns the statement declaring a node with its shape and label.
+func dotNode(n Node) string {
+	attrs := []string{"label=" + dotString(n.Label), "shape=" + dotShape(n)}
+
+	var style []string
+	if n.Shape == figma.ShapeTypeRoundedRectangle {
+		style = append(style, "rounded")
+	}
+	if n.Fill != "" {
+		style = append(style, "filled")
+		attrs = append(attrs, "fillcolor="+dotString(n.Fill))
+	}
+	if len(style) > 0 {
+		attrs = append(attrs, "style="+dotString(strings.Join(style, ",")))
+	}
+
+	return fmt.Sprintf("%s [%s];", dotString(n.ID), strings.Join(attrs, ", "))
+}
+
+func dotShape(n Node) string {
+	if n.Type == figma.NodeTypeSticky {
+		return "note"
+	}
+
+	switch n.Shape {
+	case figma.ShapeTypeEllipse:
+		return "ellipse"
+	case figma.ShapeTypeDiamond:
+		return "diamond"
+	case figma.ShapeTypeTriangleUp:
+		return "triangle"
+	case figma.ShapeTypeTriangleDown:
+		return "invtriangle"
+	case figma.ShapeTypeParallelogramRight, figma.ShapeTypeParallelogramLeft:
+		return "parallelogram"
+	case figma.ShapeTypeTrapezoid:
+		return "trapezium"
+	case figma.ShapeTypeHexagon:
+		return "hexagon"
+	case figma.ShapeTypePentagon:
+		return "pentagon"
+	case figma.ShapeTypeOctagon:
+		return "octagon"
+	case figma.ShapeTypeStar:
+		return "star"
+	case figma.ShapeTypeEngDatabase:
+		return "cylinder"
+	case figma.ShapeTypeEngFolder:
+		return "folder"
+	case figma.ShapeTypeDocumentSingle, figma.ShapeTypeDocumentMultiple, figma.ShapeTypeEngFile:
+		return "note"
+	default:
+		return "box"
+	}
+}
+
+// dotString returns s as a quoted DOT string.
+func dotString(s string) string {
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s) + `"`
+}
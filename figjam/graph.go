@@ -0,0 +1,211 @@
+// Package figjam converts FigJam boards into diagrams: stickies and shapes
+// become nodes, connectors become edges, and sections become groups. The
+// diagrams can be written as Mermaid flowcharts or Graphviz DOT graphs, so
+// boards can be kept as text alongside the code they describe.
+//
+// The REST API does not return the author of a sticky, only whether the
+// author is shown on the board, so diagrams do not include authors.
+package figjam
+
+import "github.com/torie/figma"
+
+// Direction is the direction a diagram flows in.
+type Direction string
+
+const (
+	DirectionTopToBottom Direction = "TB"
+	DirectionBottomToTop Direction = "BT"
+	DirectionLeftToRight Direction = "LR"
+	DirectionRightToLeft Direction = "RL"
+)
+
+// Graph is a diagram read from a FigJam board.
+type Graph struct {
+	// The direction the diagram flows in, top to bottom if empty
+	Direction Direction
+
+	// The nodes of the diagram: the stickies and shapes in the order they
+	// appear on the board, followed by the other nodes connectors attach to
+	Nodes []Node
+
+	// The edges between nodes
+	Edges []Edge
+
+	// The sections of the board which are not inside another section
+	Groups []*Group
+}
+
+// Node is a node of a diagram: a sticky, a shape with text, or another node a
+// connector is attached to.
+type Node struct {
+	// The ID of the node in the file
+	ID string
+
+	// The text of the node, or its name if it has no text
+	Label string
+
+	// The type of the node in the file, e.g. STICKY
+	Type figma.NodeType
+
+	// The shape of shapes with text
+	Shape figma.ShapeType
+
+	// The background color in hexadecimal notation, empty if it has none
+	Fill string
+
+	// Whether the author of a sticky is shown on the board
+	AuthorVisible bool
+}
+
+// Edge is a connector between two nodes.
+type Edge struct {
+	// The ID of the connector in the file
+	ID string
+
+	// The IDs of the nodes the connector starts and ends at
+	From, To string
+
+	// The text of the connector
+	Label string
+
+	// Whether an arrow is drawn at the start and at the end of the connector
+	StartArrow, EndArrow bool
+}
+
+// Group is a section of a board.
+type Group struct {
+	// The ID of the section in the file
+	ID string
+
+	// The name of the section
+	Name string
+
+	// The IDs of the nodes directly inside the section
+	NodeIDs []string
+
+	// The sections nested inside the section
+	Groups []*Group
+}
+
+// FromPage returns the diagram on a page of a FigJam file. Hidden nodes are
+// left out. Connectors are only included when both of their endpoints are
+// attached to a node, and the nodes they attach to are included even if they
+// are not stickies or shapes.
+func FromPage(page *figma.Node) *Graph {
+	b := builder{
+		g:        &Graph{},
+		nodes:    make(map[string]*figma.Node),
+		groups:   make(map[string]*Group),
+		included: make(map[string]bool),
+	}
+	for i := range page.Children {
+		b.visit(&page.Children[i], nil)
+	}
+
+	for _, c := range b.connectors {
+		from, to := c.ConnectorStart.EndpointNodeID, c.ConnectorEnd.EndpointNodeID
+		if b.nodes[from] == nil || b.nodes[to] == nil {
+			continue
+		}
+		b.include(from)
+		b.include(to)
+		b.g.Edges = append(b.g.Edges, Edge{
+			ID:         c.ID,
+			From:       from,
+			To:         to,
+			Label:      c.Characters,
+			StartArrow: arrow(c.ConnectorStartStrokeCap),
+			EndArrow:   arrow(c.ConnectorEndStrokeCap),
+		})
+	}
+
+	return b.g
+}
+
+type builder struct {
+	g          *Graph
+	connectors []*figma.Node
+
+	// All visible nodes and the sections containing them, by ID
+	nodes  map[string]*figma.Node
+	groups map[string]*Group
+
+	// The nodes added to the diagram, by ID
+	included map[string]bool
+}
+
+func (b *builder) visit(n *figma.Node, group *Group) {
+	if !n.Visible {
+		return
+	}
+	b.nodes[n.ID] = n
+	b.groups[n.ID] = group
+
+	switch n.Type {
+	case figma.NodeTypeSection:
+		s := &Group{ID: n.ID, Name: n.Name}
+		if group == nil {
+			b.g.Groups = append(b.g.Groups, s)
+		} else {
+			group.Groups = append(group.Groups, s)
+		}
+		group = s
+	case figma.NodeTypeSticky, figma.NodeTypeShapeWithText:
+		b.include(n.ID)
+	case figma.NodeTypeConnector:
+		b.connectors = append(b.connectors, n)
+	}
+
+	for i := range n.Children {
+		b.visit(&n.Children[i], group)
+	}
+}
+
+// include adds the visible node with the given ID to the diagram, unless it
+// was already added.
+func (b *builder) include(id string) {
+	if b.included[id] {
+		return
+	}
+	n := b.nodes[id]
+
+	label := n.Characters
+	if label == "" {
+		label = n.Name
+	}
+	b.included[id] = true
+	b.g.Nodes = append(b.g.Nodes, Node{
+		ID:            n.ID,
+		Label:         label,
+		Type:          n.Type,
+		Shape:         n.ShapeType,
+		Fill:          fill(n),
+		AuthorVisible: n.AuthorVisible,
+	})
+
+	if g := b.groups[id]; g != nil {
+		g.NodeIDs = append(g.NodeIDs, id)
+	}
+}
+
+// fill returns the color of the topmost visible solid fill of n.
+func fill(n *figma.Node) string {
+	for i := len(n.Fills) - 1; i >= 0; i-- {
+		p := n.Fills[i]
+		if p.Visible && p.PaintType == figma.PaintTypeSolid {
+			return p.Color.WithOpacity(p.Opacity).Hex()
+		}
+	}
+	return ""
+}
+
+// arrow reports whether the cap of a connector is an arrow head. Connectors
+// may also use the names of the caps in the plugin API.
+func arrow(c figma.StrokeCap) bool {
+	switch c {
+	case figma.StrokeCapLineArrow, figma.StrokeCapTriangleArrow, figma.StrokeCapTriangleFilled,
+		"ARROW_LINES", "ARROW_EQUILATERAL":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package figjam
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/torie/figma"
+)
+
+const board = `{"id":"0:1","type":"CANVAS","children":[
+	{"id":"1:1","type":"SECTION","name":"Backend","children":[
+		{"id":"1:2","type":"STICKY","characters":"API","authorVisible":true,"fills":[{"type":"SOLID","color":{"r":1,"g":0.85,"b":0.4,"a":1}}]},
+		{"id":"1:5","type":"SECTION","name":"Data","children":[{"id":"1:3","type":"SHAPE_WITH_TEXT","shapeType":"ENG_DATABASE","characters":"DB"}]}]},
+	{"id":"2:1","type":"SHAPE_WITH_TEXT","shapeType":"DIAMOND","characters":"Client"},
+	{"id":"2:2","type":"TEXT","name":"note","characters":"free text"},
+	{"id":"2:3","type":"STICKY","characters":"hidden","visible":false},
+	{"id":"3:1","type":"CONNECTOR","characters":"calls","connectorStart":{"endpointNodeId":"2:1"},"connectorEnd":{"endpointNodeId":"1:2"},"connectorEndStrokeCap":"ARROW_LINES"},
+	{"id":"3:2","type":"CONNECTOR","connectorStart":{"endpointNodeId":"1:2"},"connectorEnd":{"endpointNodeId":"1:3"},"connectorEndStrokeCap":"TRIANGLE_FILLED"},
+	{"id":"3:3","type":"CONNECTOR","connectorStart":{"endpointNodeId":"2:2"},"connectorEnd":{"position":{"x":1,"y":2}}},
+	{"id":"3:4","type":"CONNECTOR","connectorStart":{"endpointNodeId":"2:1"},"connectorEnd":{"endpointNodeId":"2:3"}}]}`
+
+func TestFromPage(t *testing.T) {
+	var page figma.Node
+	if err := json.Unmarshal([]byte(board), &page); err != nil {
+		t.Fatal(err)
+	}
+	g := FromPage(&page)
+
+	var ids []string
+	for _, n := range g.Nodes {
+		ids = append(ids, n.ID)
+	}
+	if len(ids) != 3 || ids[0] != "1:2" || ids[1] != "1:3" || ids[2] != "2:1" {
+		t.Errorf("nodes = %v, want the visible stickies and shapes", ids)
+	}
+	if n := g.Nodes[0]; !n.AuthorVisible || n.Fill != "#FFD966" {
+		t.Errorf("sticky = %+v", n)
+	}
+
+	if len(g.Edges) != 2 {
+		t.Fatalf("edges = %+v, want the connectors attached to visible nodes at both ends", g.Edges)
+	}
+	if e := g.Edges[0]; e.From != "2:1" || e.To != "1:2" || e.Label != "calls" || e.StartArrow || !e.EndArrow {
+		t.Errorf("edge = %+v", e)
+	}
+
+	if len(g.Groups) != 1 || g.Groups[0].NodeIDs[0] != "1:2" || len(g.Groups[0].Groups) != 1 || g.Groups[0].Groups[0].NodeIDs[0] != "1:3" {
+		t.Errorf("groups = %+v", g.Groups)
+	}
+}
+
+// labels is a diagram whose labels contain characters which must be escaped.
+var labels = &Graph{
+	Direction: DirectionLeftToRight,
+	Nodes: []Node{
+		{ID: "1:2", Label: `Say "hi"`, Type: figma.NodeTypeSticky, Fill: "#FFD966"},
+		{ID: "1:3", Label: "a[0] ]\nnext", Type: figma.NodeTypeShapeWithText, Shape: figma.ShapeTypeDiamond},
+		{ID: "2:1", Label: `C# <b>x</b> #quot; \n`, Type: figma.NodeTypeShapeWithText, Shape: figma.ShapeTypeRoundedRectangle},
+	},
+	Edges: []Edge{
+		{ID: "3:1", From: "2:1", To: "1:2", Label: "uses \"x\"]\r\nnow", EndArrow: true},
+		{ID: "3:2", From: "1:2", To: "1:3", StartArrow: true},
+	},
+	Groups: []*Group{{ID: "1:1", Name: `Back"end]`, NodeIDs: []string{"1:2"}}},
+}
+
+func TestMermaid(t *testing.T) {
+	want := `flowchart LR
+    subgraph s1_1["Back#quot;end]"]
+        n1_2["Say #quot;hi#quot;"]
+    end
+    n1_3{"a[0] ]<br>next"}
+    n2_1("C#35; #lt;b#gt;x#lt;/b#gt; #35;quot; \n")
+    n2_1 -->|"uses #quot;x#quot;]<br>now"| n1_2
+    n1_3 --> n1_2
+`
+	if got := labels.Mermaid(); got != want {
+		t.Errorf("Mermaid() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDOT(t *testing.T) {
+	want := `digraph {
+	rankdir=LR;
+	subgraph "cluster_1:1" {
+		label="Back\"end]";
+		"1:2" [label="Say \"hi\"", shape=note, fillcolor="#FFD966", style="filled"];
+	}
+	"1:3" [label="a[0] ]\nnext", shape=diamond];
+	"2:1" [label="C# <b>x</b> #quot; \\n", shape=box, style="rounded"];
+	"2:1" -> "1:2" [label="uses \"x\"]\nnow"];
+	"1:2" -> "1:3" [dir=back];
+}
+`
+	if got := labels.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+}
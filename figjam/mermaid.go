@@ -0,0 +1,119 @@
+package figjam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/torie/figma"
+)
+
+// Mermaid returns the diagram as a Mermaid flowchart. Sections become
+// subgraphs, and shapes are drawn with the closest Mermaid shape.
+func (g *Graph) Mermaid() string {
+	var b strings.Builder
+
+	dir := g.Direction
+	if dir == "" {
+		dir = DirectionTopToBottom
+	}
+	fmt.Fprintf(&b, "flowchart %s\n", dir)
+
+	nodes := make(map[string]Node, len(g.Nodes))
+	grouped := make(map[string]bool)
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+
+	var group func(gr *Group, indent string)
+	group = func(gr *Group, indent string) {
+		fmt.Fprintf(&b, "%ssubgraph %s[\"%s\"]\n", indent, mermaidID("s", gr.ID), mermaidText(gr.Name))
+		for _, id := range gr.NodeIDs {
+			grouped[id] = true
+			fmt.Fprintf(&b, "%s    %s\n", indent, mermaidNode(nodes[id]))
+		}
+		for _, s := range gr.Groups {
+			group(s, indent+"    ")
+		}
+		fmt.Fprintf(&b, "%send\n", indent)
+	}
+	for _, gr := range g.Groups {
+		group(gr, "    ")
+	}
+
+	for _, n := range g.Nodes {
+		if !grouped[n.ID] {
+			fmt.Fprintf(&b, "    %s\n", mermaidNode(n))
+		}
+	}
+
+	for _, e := range g.Edges {
+		from, to := mermaidID("n", e.From), mermaidID("n", e.To)
+
+		link := "---"
+		switch {
+		case e.StartArrow && e.EndArrow:
+			link = "<-->"
+		case e.EndArrow:
+			link = "-->"
+		case e.StartArrow:
+			// Flowcharts have no arrow pointing back to the start.
+			from, to, link = to, from, "-->"
+		}
+		if e.Label != "" {
+			link += fmt.Sprintf("|\"%s\"|", mermaidText(e.Label))
+		}
+
+		fmt.Fprintf(&b, "    %s %s %s\n", from, link, to)
+	}
+
+	return b.String()
+}
+
+// mermaidNode returns the declaration of a node with its shape and label.
+func mermaidNode(n Node) string {
+	open, close := "[", "]"
+	switch n.Shape {
+	case figma.ShapeTypeRoundedRectangle:
+		open, close = "(", ")"
+	case figma.ShapeTypeEllipse:
+		open, close = "((", "))"
+	case figma.ShapeTypeDiamond:
+		open, close = "{", "}"
+	case figma.ShapeTypeHexagon:
+		open, close = "{{", "}}"
+	case figma.ShapeTypeParallelogramRight:
+		open, close = "[/", "/]"
+	case figma.ShapeTypeParallelogramLeft:
+		open, close = "[\\", "\\]"
+	case figma.ShapeTypeTrapezoid, figma.ShapeTypeTriangleUp:
+		open, close = "[/", "\\]"
+	case figma.ShapeTypeTriangleDown:
+		open, close = "[\\", "/]"
+	case figma.ShapeTypeEngDatabase:
+		open, close = "[(", ")]"
+	case figma.ShapeTypePredefinedProcess:
+		open, close = "[[", "]]"
+	}
+
+	return fmt.Sprintf("%s%s\"%s\"%s", mermaidID("n", n.ID), open, mermaidText(n.Label), close)
+}
+
+// mermaidID returns an identifier for a node ID, which contains characters
+// Mermaid does not allow in identifiers.
+func mermaidID(prefix, id string) string {
+	return prefix + strings.NewReplacer(":", "_", ";", "__", "-", "_").Replace(id)
+}
+
+// mermaidText escapes text for use in a quoted Mermaid label. Mermaid decodes
+// entity codes such as #quot; and renders HTML in labels, so "#", "<" and ">"
+// are escaped too.
+func mermaidText(s string) string {
+	return strings.NewReplacer(
+		`"`, "#quot;",
+		"#", "#35;",
+		"<", "#lt;",
+		">", "#gt;",
+		"\r\n", "<br>",
+		"\n", "<br>",
+	).Replace(s)
+}
@@ -0,0 +1,90 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/torie/figma"
+)
+
+// Intersect returns the intersection of the rectangles a and b. It reports
+// false if they do not overlap.
+func Intersect(a, b figma.Rectangle) (figma.Rectangle, bool) {
+	x0, y0 := math.Max(a.X, b.X), math.Max(a.Y, b.Y)
+	x1, y1 := math.Min(a.X+a.Width, b.X+b.Width), math.Min(a.Y+a.Height, b.Y+b.Height)
+	if x1 < x0 || y1 < y0 {
+		return figma.Rectangle{}, false
+	}
+	return figma.Rectangle{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}, true
+}
+
+// Intersects reports whether the rectangles a and b overlap. Rectangles which
+// only share an edge overlap.
+func Intersects(a, b figma.Rectangle) bool {
+	_, ok := Intersect(a, b)
+	return ok
+}
+
+// Union returns the smallest rectangle containing both a and b.
+func Union(a, b figma.Rectangle) figma.Rectangle {
+	return a.Union(b)
+}
+
+// Contains reports whether the rectangle b lies entirely within a.
+func Contains(a, b figma.Rectangle) bool {
+	return b.X >= a.X && b.Y >= a.Y &&
+		b.X+b.Width <= a.X+a.Width && b.Y+b.Height <= a.Y+a.Height
+}
+
+// ContainsPoint reports whether the point p lies within the rectangle r,
+// including its edges.
+func ContainsPoint(r figma.Rectangle, p figma.Vector) bool {
+	return p.X >= r.X && p.Y >= r.Y && p.X <= r.X+r.Width && p.Y <= r.Y+r.Height
+}
+
+// Hit reports whether the point p on the canvas lies within the node n, whose
+// absolute transform is abs. Rotated nodes are tested against their rotated
+// outline rather than their bounding box when their size is known.
+func Hit(n *figma.Node, abs Transform, p figma.Vector) bool {
+	if n.Size == (figma.Vector{}) {
+		return ContainsPoint(n.AbsoluteBoundingBox, p)
+	}
+
+	inv, ok := abs.Invert()
+	if !ok {
+		return false
+	}
+	return ContainsPoint(figma.Rectangle{Width: n.Size.X, Height: n.Size.Y}, inv.Apply(p))
+}
+
+// HitTest returns the visible nodes below root which contain the point p on
+// the canvas, topmost first: children before their parents, and later
+// siblings before earlier ones. Children of hidden nodes are skipped, as are
+// children of frames clipping their content outside of the frame. root is a
+// document, a canvas or a top level node.
+func HitTest(root *figma.Node, p figma.Vector) []*figma.Node {
+	var hits []*figma.Node
+
+	var visit func(path []*figma.Node)
+	visit = func(path []*figma.Node) {
+		n := path[len(path)-1]
+		if !n.Visible {
+			return
+		}
+
+		isCanvas := n.Type == figma.NodeTypeDocument || n.Type == figma.NodeTypeCanvas
+		hit := !isCanvas && Hit(n, AbsoluteTransform(path), p)
+		if n.ClipsContent && !hit {
+			return
+		}
+
+		for i := len(n.Children) - 1; i >= 0; i-- {
+			visit(append(path[:len(path):len(path)], &n.Children[i]))
+		}
+		if hit {
+			hits = append(hits, n)
+		}
+	}
+	visit([]*figma.Node{root})
+
+	return hits
+}
@@ -0,0 +1,88 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/torie/figma"
+)
+
+func TestIntersect(t *testing.T) {
+	a := figma.Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	tests := []struct {
+		name string
+		b    figma.Rectangle
+		want figma.Rectangle
+		ok   bool
+	}{
+		{"overlapping", figma.Rectangle{X: 5, Y: 5, Width: 10, Height: 10}, figma.Rectangle{X: 5, Y: 5, Width: 5, Height: 5}, true},
+		{"contained", figma.Rectangle{X: 2, Y: 3, Width: 4, Height: 5}, figma.Rectangle{X: 2, Y: 3, Width: 4, Height: 5}, true},
+		{"same", a, a, true},
+		{"shared edge", figma.Rectangle{X: 10, Y: 0, Width: 5, Height: 10}, figma.Rectangle{X: 10, Y: 0, Width: 0, Height: 10}, true},
+		{"shared corner", figma.Rectangle{X: 10, Y: 10, Width: 5, Height: 5}, figma.Rectangle{X: 10, Y: 10}, true},
+		{"empty inside", figma.Rectangle{X: 3, Y: 3}, figma.Rectangle{X: 3, Y: 3}, true},
+		{"disjoint horizontally", figma.Rectangle{X: 11, Y: 0, Width: 5, Height: 5}, figma.Rectangle{}, false},
+		{"disjoint vertically", figma.Rectangle{X: 0, Y: -6, Width: 5, Height: 5}, figma.Rectangle{}, false},
+		{"disjoint diagonally", figma.Rectangle{X: 20, Y: 20, Width: 5, Height: 5}, figma.Rectangle{}, false},
+	}
+
+	for _, tt := range tests {
+		for _, order := range [][2]figma.Rectangle{{a, tt.b}, {tt.b, a}} {
+			got, ok := Intersect(order[0], order[1])
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("%s: Intersect(%+v, %+v) = %+v, %v, want %+v, %v", tt.name, order[0], order[1], got, ok, tt.want, tt.ok)
+			}
+			if Intersects(order[0], order[1]) != tt.ok {
+				t.Errorf("%s: Intersects = %v, want %v", tt.name, !tt.ok, tt.ok)
+			}
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b figma.Rectangle
+		want figma.Rectangle
+	}{
+		{"overlapping", figma.Rectangle{Width: 10, Height: 10}, figma.Rectangle{X: 5, Y: 5, Width: 10, Height: 10}, figma.Rectangle{Width: 15, Height: 15}},
+		{"disjoint", figma.Rectangle{X: -5, Y: 0, Width: 5, Height: 5}, figma.Rectangle{X: 10, Y: 20, Width: 5, Height: 5}, figma.Rectangle{X: -5, Y: 0, Width: 20, Height: 25}},
+		{"contained", figma.Rectangle{Width: 10, Height: 10}, figma.Rectangle{X: 2, Y: 2, Width: 2, Height: 2}, figma.Rectangle{Width: 10, Height: 10}},
+		{"empty", figma.Rectangle{X: 1, Y: 1, Width: 2, Height: 2}, figma.Rectangle{X: 5, Y: 5}, figma.Rectangle{X: 1, Y: 1, Width: 4, Height: 4}},
+	}
+
+	for _, tt := range tests {
+		if got := Union(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: Union(a, b) = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if got := Union(tt.b, tt.a); got != tt.want {
+			t.Errorf("%s: Union(b, a) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	a := figma.Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	tests := []struct {
+		name string
+		b    figma.Rectangle
+		want bool
+	}{
+		{"inside", figma.Rectangle{X: 2, Y: 2, Width: 5, Height: 5}, true},
+		{"same", a, true},
+		{"touching edges", figma.Rectangle{X: 5, Y: 0, Width: 5, Height: 10}, true},
+		{"empty inside", figma.Rectangle{X: 10, Y: 10}, true},
+		{"overlapping", figma.Rectangle{X: 5, Y: 5, Width: 10, Height: 2}, false},
+		{"enclosing", figma.Rectangle{X: -1, Y: -1, Width: 12, Height: 12}, false},
+		{"disjoint", figma.Rectangle{X: 20, Y: 20, Width: 1, Height: 1}, false},
+	}
+
+	for _, tt := range tests {
+		if got := Contains(a, tt.b); got != tt.want {
+			t.Errorf("%s: Contains = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if !ContainsPoint(a, figma.Vector{X: 10, Y: 0}) || ContainsPoint(a, figma.Vector{X: 10.5, Y: 0}) {
+		t.Errorf("ContainsPoint does not include exactly the edges")
+	}
+}
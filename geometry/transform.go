@@ -0,0 +1,92 @@
+// Package geometry provides affine transforms and bounding box math for the
+// nodes of a Figma document.
+package geometry
+
+import (
+	"math"
+
+	"github.com/torie/figma"
+)
+
+// Transform is a 2x3 affine transformation matrix, the type of the
+// transforms of nodes. Its methods multiply, invert and apply transforms.
+type Transform = figma.Transform
+
+// Identity is the transform leaving points unchanged.
+var Identity = Transform{{1, 0, 0}, {0, 1, 0}}
+
+// Translate returns the transform moving points by x and y.
+func Translate(x, y float64) Transform {
+	return Transform{{1, 0, x}, {0, 1, y}}
+}
+
+// Scale returns the transform scaling points by sx and sy.
+func Scale(sx, sy float64) Transform {
+	return Transform{{sx, 0, 0}, {0, sy, 0}}
+}
+
+// Rotate returns the transform rotating points by the angle in radians,
+// clockwise on the canvas since its y axis points down.
+func Rotate(angle float64) Transform {
+	sin, cos := math.Sincos(angle)
+	return Transform{{cos, -sin, 0}, {sin, cos, 0}}
+}
+
+// AbsoluteTransform returns the transform from the coordinate space of the
+// last node of path to the canvas. path is the chain of nodes from a top level
+// node or the document down to the node, as returned by Path.
+//
+// The relative transform of a node is relative to its containing parent,
+// which skips groups and boolean operations. Nodes decoded without their
+// relative transform, which Figma only returns along with geometry paths, are
+// placed at the corner of their bounding box without rotation.
+func AbsoluteTransform(path []*figma.Node) Transform {
+	container, abs := Identity, Identity
+	for _, n := range path {
+		switch {
+		case n.Type == figma.NodeTypeDocument || n.Type == figma.NodeTypeCanvas:
+			abs = Identity
+		case n.RelativeTransform == figma.Transform{}:
+			abs = Translate(n.AbsoluteBoundingBox.X, n.AbsoluteBoundingBox.Y)
+		default:
+			abs = container.Multiply(n.RelativeTransform)
+		}
+
+		if n.Type != figma.NodeTypeGroup && n.Type != figma.NodeTypeBooleanOperation {
+			container = abs
+		}
+	}
+	return abs
+}
+
+// AbsoluteTransforms returns the absolute transforms of root and all its
+// descendants, mapped by node ID. root is treated as a top level node.
+func AbsoluteTransforms(root *figma.Node) map[string]Transform {
+	res := make(map[string]Transform)
+
+	var walk func(path []*figma.Node)
+	walk = func(path []*figma.Node) {
+		n := path[len(path)-1]
+		res[n.ID] = AbsoluteTransform(path)
+		for i := range n.Children {
+			walk(append(path[:len(path):len(path)], &n.Children[i]))
+		}
+	}
+	walk([]*figma.Node{root})
+
+	return res
+}
+
+// Path returns the chain of nodes from root down to the node with the given
+// ID, or nil if root does not contain it.
+func Path(root *figma.Node, id string) []*figma.Node {
+	if root.ID == id {
+		return []*figma.Node{root}
+	}
+	for i := range root.Children {
+		if p := Path(&root.Children[i], id); p != nil {
+			return append([]*figma.Node{root}, p...)
+		}
+	}
+	return nil
+}
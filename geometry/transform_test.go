@@ -0,0 +1,259 @@
+package geometry
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/torie/figma"
+)
+
+// near reports whether the transforms t and u are equal up to rounding.
+func near(t, u Transform) bool {
+	for i := range t {
+		for j := range t[i] {
+			if math.Abs(t[i][j]-u[i][j]) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nearRect reports whether the rectangles a and b are equal up to rounding.
+func nearRect(a, b figma.Rectangle) bool {
+	return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9 &&
+		math.Abs(a.Width-b.Width) < 1e-9 && math.Abs(a.Height-b.Height) < 1e-9
+}
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name string
+		t, u Transform
+		want Transform
+	}{
+		{"identity", Identity, Translate(1, 2), Translate(1, 2)},
+		{"translations", Translate(1, 2), Translate(3, 4), Translate(4, 6)},
+		{"scale after translate", Scale(2, 3), Translate(1, 1), Transform{{2, 0, 2}, {0, 3, 3}}},
+		{"translate after scale", Translate(1, 1), Scale(2, 3), Transform{{2, 0, 1}, {0, 3, 1}}},
+		{"rotations", Rotate(math.Pi / 4), Rotate(math.Pi / 4), Rotate(math.Pi / 2)},
+		{"rotate after translate", Rotate(math.Pi / 2), Translate(1, 0), Transform{{0, -1, 0}, {1, 0, 1}}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.t.Multiply(tt.u); !near(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInvert(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Transform
+		ok   bool
+	}{
+		{"identity", Identity, true},
+		{"translate", Translate(5, -3), true},
+		{"scale", Scale(2, 0.5), true},
+		{"flip", Scale(-1, 1), true},
+		{"composed", Translate(10, 20).Multiply(Rotate(0.3)).Multiply(Scale(2, 3)), true},
+		{"zero width", Scale(0, 1), false},
+		{"collapsed", Transform{{1, 2, 0}, {2, 4, 0}}, false},
+		{"zero", Transform{}, false},
+	}
+
+	for _, tt := range tests {
+		inv, ok := tt.t.Invert()
+		if ok != tt.ok {
+			t.Errorf("%s: invertible = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := tt.t.Multiply(inv); !near(got, Identity) {
+			t.Errorf("%s: t × inverse = %v", tt.name, got)
+		}
+		if got := inv.Multiply(tt.t); !near(got, Identity) {
+			t.Errorf("%s: inverse × t = %v", tt.name, got)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Transform
+		p    figma.Vector
+		want figma.Vector
+	}{
+		{"identity", Identity, figma.Vector{X: 3, Y: 4}, figma.Vector{X: 3, Y: 4}},
+		{"translate", Translate(10, 20), figma.Vector{X: 3, Y: 4}, figma.Vector{X: 13, Y: 24}},
+		{"scale", Scale(2, 3), figma.Vector{X: 3, Y: 4}, figma.Vector{X: 6, Y: 12}},
+		{"rotate", Rotate(math.Pi / 2), figma.Vector{X: 1, Y: 0}, figma.Vector{X: 0, Y: 1}},
+		{"composed", Translate(10, 20).Multiply(Rotate(math.Pi / 2)).Multiply(Scale(2, 3)), figma.Vector{X: 3, Y: 4}, figma.Vector{X: -2, Y: 26}},
+	}
+
+	for _, tt := range tests {
+		got := tt.t.Apply(tt.p)
+		if math.Abs(got.X-tt.want.X) > 1e-9 || math.Abs(got.Y-tt.want.Y) > 1e-9 {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+
+		// The inverse maps the point back.
+		if inv, ok := tt.t.Invert(); ok {
+			if back := inv.Apply(got); math.Abs(back.X-tt.p.X) > 1e-9 || math.Abs(back.Y-tt.p.Y) > 1e-9 {
+				t.Errorf("%s: inverse gives %+v, want %+v", tt.name, back, tt.p)
+			}
+		}
+	}
+}
+
+func TestBounds(t *testing.T) {
+	s2 := math.Sqrt2
+	tests := []struct {
+		name string
+		t    Transform
+		size figma.Vector
+		want figma.Rectangle
+	}{
+		{"identity", Identity, figma.Vector{X: 10, Y: 5}, figma.Rectangle{Width: 10, Height: 5}},
+		{"translate", Translate(3, 4), figma.Vector{X: 10, Y: 5}, figma.Rectangle{X: 3, Y: 4, Width: 10, Height: 5}},
+		{"flip", Scale(-1, 1), figma.Vector{X: 10, Y: 5}, figma.Rectangle{X: -10, Width: 10, Height: 5}},
+		{"quarter turn", Rotate(math.Pi / 2), figma.Vector{X: 10, Y: 5}, figma.Rectangle{X: -5, Width: 5, Height: 10}},
+		{"eighth turn", Rotate(math.Pi / 4), figma.Vector{X: 10, Y: 10}, figma.Rectangle{X: -5 * s2, Width: 10 * s2, Height: 10 * s2}},
+		{"moved eighth turn", Translate(100, 50).Multiply(Rotate(-math.Pi / 4)), figma.Vector{X: 10, Y: 10}, figma.Rectangle{X: 100, Y: 50 - 5*s2, Width: 10 * s2, Height: 10 * s2}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.t.Bounds(tt.size); !nearRect(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// nestedFixture is a page holding a frame turned a quarter clockwise, with a
+// frame turned another quarter inside it, and a group whose child is placed
+// relative to the outer frame.
+const nestedFixture = `{
+	"id": "0:1",
+	"type": "CANVAS",
+	"children": [{
+		"id": "1:1",
+		"type": "FRAME",
+		"size": {"x": 100, "y": 50},
+		"relativeTransform": [[0, -1, 200], [1, 0, 100]],
+		"children": [{
+			"id": "1:2",
+			"type": "FRAME",
+			"size": {"x": 20, "y": 10},
+			"relativeTransform": [[0, -1, 10], [1, 0, 0]]
+		}, {
+			"id": "1:3",
+			"type": "GROUP",
+			"size": {"x": 5, "y": 5},
+			"relativeTransform": [[1, 0, 40], [0, 1, 20]],
+			"children": [{
+				"id": "1:4",
+				"type": "RECTANGLE",
+				"size": {"x": 5, "y": 5},
+				"relativeTransform": [[1, 0, 40], [0, 1, 20]]
+			}]
+		}, {
+			"id": "1:5",
+			"type": "RECTANGLE",
+			"visible": false,
+			"size": {"x": 100, "y": 50},
+			"relativeTransform": [[1, 0, 0], [0, 1, 0]]
+		}]
+	}, {
+		"id": "2:1",
+		"type": "FRAME",
+		"absoluteBoundingBox": {"x": 300, "y": 300, "width": 10, "height": 10}
+	}]
+}`
+
+func decodeNested(t *testing.T) *figma.Node {
+	t.Helper()
+	var n figma.Node
+	if err := json.Unmarshal([]byte(nestedFixture), &n); err != nil {
+		t.Fatal(err)
+	}
+	return &n
+}
+
+func TestAbsoluteTransform(t *testing.T) {
+	page := decodeNested(t)
+	outer := Translate(200, 100).Multiply(Rotate(math.Pi / 2))
+
+	tests := []struct {
+		id   string
+		want Transform
+	}{
+		{"0:1", Identity},
+		{"1:1", outer},
+		{"1:2", outer.Multiply(Translate(10, 0)).Multiply(Rotate(math.Pi / 2))},
+		{"1:3", outer.Multiply(Translate(40, 20))},
+		// Children of groups are relative to the frame containing the group.
+		{"1:4", outer.Multiply(Translate(40, 20))},
+		// Nodes without a relative transform are placed at their bounds.
+		{"2:1", Translate(300, 300)},
+	}
+
+	all := AbsoluteTransforms(page)
+	for _, tt := range tests {
+		path := Path(page, tt.id)
+		if path == nil {
+			t.Fatalf("%s: no path", tt.id)
+		}
+		if got := AbsoluteTransform(path); !near(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.id, got, tt.want)
+		}
+		if got := all[tt.id]; !near(got, tt.want) {
+			t.Errorf("%s: AbsoluteTransforms gives %v, want %v", tt.id, got, tt.want)
+		}
+	}
+
+	if Path(page, "9:9") != nil {
+		t.Errorf("path to a missing node")
+	}
+}
+
+func TestHitTest(t *testing.T) {
+	page := decodeNested(t)
+
+	// The outer frame covers x 150 to 200 and y 100 to 200 on the canvas,
+	// the inner frame x 180 to 200 and y 100 to 110, the rectangle in the
+	// group x 175 to 180 and y 140 to 145.
+	tests := []struct {
+		name string
+		p    figma.Vector
+		want []string
+	}{
+		{"inner frame", figma.Vector{X: 190, Y: 105}, []string{"1:2", "1:1"}},
+		{"outer frame", figma.Vector{X: 160, Y: 105}, []string{"1:1"}},
+		{"beside inner frame", figma.Vector{X: 190, Y: 150}, []string{"1:1"}},
+		{"group", figma.Vector{X: 177, Y: 142}, []string{"1:4", "1:3", "1:1"}},
+		{"outside rotated frame", figma.Vector{X: 250, Y: 120}, nil},
+		{"frame without transform", figma.Vector{X: 305, Y: 305}, []string{"2:1"}},
+		{"nothing", figma.Vector{X: 0, Y: 0}, nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, n := range HitTest(page, tt.p) {
+			got = append(got, n.ID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -31,3 +31,11 @@ type Image struct {
 	NodeID string
 	URL    string
 }
+
+type imageFillsResponse struct {
+	Error  bool `json:"error"`
+	Status int  `json:"status"`
+	Meta   struct {
+		Images map[string]string `json:"images"`
+	} `json:"meta"`
+}
@@ -0,0 +1,364 @@
+package figma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldSet is the set of JSON keys present when a value was decoded.
+type fieldSet map[string]bool
+
+// structFields are the fields of a struct type encoded to JSON.
+type structFields struct {
+	// The field indexes, mapped by JSON key
+	index map[string][]int
+
+	// The JSON keys in the order the fields are declared
+	keys []string
+
+	// The JSON keys of the fields tagged omitempty
+	omitEmpty map[string]bool
+}
+
+// jsonFieldCache maps struct types to the JSON keys of their fields.
+var jsonFieldCache sync.Map
+
+// jsonFields returns the JSON keys of the fields of the struct type t, including
+// the fields of embedded structs, with the field indexes.
+func jsonFields(t reflect.Type) *structFields {
+	if f, ok := jsonFieldCache.Load(t); ok {
+		return f.(*structFields)
+	}
+
+	fields := &structFields{index: make(map[string][]int), omitEmpty: make(map[string]bool)}
+	collectFields(t, nil, fields)
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+func collectFields(t reflect.Type, index []int, fields *structFields) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		idx := append(append([]int(nil), index...), i)
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			collectFields(f.Type, idx, fields)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if name == "" {
+			name = f.Name
+		}
+		for _, o := range opts[1:] {
+			if o == "omitempty" {
+				fields.omitEmpty[name] = true
+			}
+		}
+		if _, ok := fields.index[name]; !ok {
+			fields.keys = append(fields.keys, name)
+		}
+		fields.index[name] = idx
+	}
+}
+
+// defaulter is implemented by types with properties which default to a value
+// other than the zero value when they are omitted, such as visible defaulting
+// to true.
+type defaulter interface {
+	setDefaults()
+}
+
+// defaults returns the value of type t with its defaults applied, or the
+// invalid value if t has no defaults.
+func defaults(t reflect.Type) reflect.Value {
+	d := reflect.New(t)
+	if v, ok := d.Interface().(defaulter); ok {
+		v.setDefaults()
+		return d.Elem()
+	}
+	return reflect.Value{}
+}
+
+// fieldByKey returns the JSON key and index of the field of fields matching
+// the key k. Like encoding/json, an exact match is preferred, and keys are
+// otherwise matched case-insensitively to the first field declared.
+func fieldByKey(fields *structFields, k string) (string, []int, bool) {
+	if idx, ok := fields.index[k]; ok {
+		return k, idx, true
+	}
+	for _, name := range fields.keys {
+		if strings.EqualFold(name, k) {
+			return name, fields.index[name], true
+		}
+	}
+	return "", nil, false
+}
+
+// objectDecoder is implemented by the types decoded with unmarshalObject, so
+// values nested in them are decoded from the same stream instead of being
+// scanned again at each level of the document.
+type objectDecoder interface {
+	decodeJSON(dec *json.Decoder) error
+}
+
+var objectDecoderType = reflect.TypeOf((*objectDecoder)(nil)).Elem()
+
+// unmarshalObject decodes the JSON object in data into the struct pointed to
+// by v. Properties which are omitted are set to their defaults if v is a
+// defaulter. The keys present are recorded in present, and keys which do not
+// match a field of v are kept in extra.
+func unmarshalObject(data []byte, v interface{}, present *fieldSet, extra *map[string]json.RawMessage) error {
+	return decodeObject(json.NewDecoder(bytes.NewReader(data)), v, present, extra)
+}
+
+// decodeObject is unmarshalObject for the next value of dec.
+func decodeObject(dec *json.Decoder, v interface{}, present *fieldSet, extra *map[string]json.RawMessage) error {
+	if d, ok := v.(defaulter); ok {
+		d.setDefaults()
+	}
+	*present = make(fieldSet)
+	*extra = nil
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("cannot decode %v into %T", tok, v)
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	fields := jsonFields(rv.Type())
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k := tok.(string)
+
+		name, idx, ok := fieldByKey(fields, k)
+		if !ok {
+			var r json.RawMessage
+			if err := dec.Decode(&r); err != nil {
+				return fmt.Errorf("%s: %s", k, err)
+			}
+			if *extra == nil {
+				*extra = make(map[string]json.RawMessage)
+			}
+			(*extra)[k] = r
+			continue
+		}
+
+		(*present)[name] = true
+		if err := decodeValue(dec, rv.FieldByIndex(idx)); err != nil {
+			return fmt.Errorf("%s: %s", k, err)
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// decodeValue decodes the next value of dec into f. Object decoders, and
+// slices and maps of them, are decoded from dec directly.
+func decodeValue(dec *json.Decoder, f reflect.Value) error {
+	if d, ok := f.Addr().Interface().(objectDecoder); ok {
+		return d.decodeJSON(dec)
+	}
+
+	t := f.Type()
+	switch {
+	case t.Kind() == reflect.Slice && reflect.PtrTo(t.Elem()).Implements(objectDecoderType):
+		return decodeContainer(dec, f, '[', func() error {
+			f.Set(reflect.Append(f, reflect.Zero(t.Elem())))
+			return decodeValue(dec, f.Index(f.Len()-1))
+		})
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && reflect.PtrTo(t.Elem()).Implements(objectDecoderType):
+		return decodeContainer(dec, f, '{', func() error {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			e := reflect.New(t.Elem()).Elem()
+			if err := decodeValue(dec, e); err != nil {
+				return err
+			}
+			f.SetMapIndex(reflect.ValueOf(tok.(string)).Convert(t.Key()), e)
+			return nil
+		})
+	default:
+		return dec.Decode(f.Addr().Interface())
+	}
+}
+
+// decodeContainer decodes the array or object starting with open from dec
+// into the slice or map f, calling item for each of its items. f is set to
+// nil if the value is null.
+func decodeContainer(dec *json.Decoder, f reflect.Value, open json.Delim, item func() error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		f.Set(reflect.Zero(f.Type()))
+		return nil
+	}
+	if tok != open {
+		return fmt.Errorf("cannot decode %v into %s", tok, f.Type())
+	}
+
+	if f.Kind() == reflect.Map {
+		f.Set(reflect.MakeMap(f.Type()))
+	} else {
+		f.Set(reflect.MakeSlice(f.Type(), 0, 0))
+	}
+	for dec.More() {
+		if err := item(); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// marshalObject encodes the struct v as a JSON object. Fields which were
+// present when v was decoded are always encoded, other fields only when they
+// differ from their default, which is the zero value unless v has defaults,
+// and are not empty if they are tagged omitempty. The keys in extra are
+// encoded as is.
+func marshalObject(v interface{}, present fieldSet, extra map[string]json.RawMessage) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	fields := jsonFields(rv.Type())
+	def := defaults(rv.Type())
+
+	out := make(map[string]json.RawMessage, len(fields.keys)+len(extra))
+	for k, r := range extra {
+		out[k] = r
+	}
+
+	for _, k := range fields.keys {
+		idx := fields.index[k]
+		f := rv.FieldByIndex(idx)
+		if !present[k] && (isDefault(f, def, idx) || fields.omitEmpty[k] && isEmpty(f)) {
+			continue
+		}
+
+		b, err := json.Marshal(f.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", k, err)
+		}
+		out[k] = b
+	}
+
+	return json.Marshal(out)
+}
+
+// isDefault reports whether the field f with the given index equals its value
+// in def, or is zero if def is invalid.
+func isDefault(f, def reflect.Value, idx []int) bool {
+	if !def.IsValid() {
+		return f.IsZero()
+	}
+	return reflect.DeepEqual(f.Interface(), def.FieldByIndex(idx).Interface())
+}
+
+// isEmpty reports whether f is empty as defined by the omitempty option of
+// encoding/json.
+func isEmpty(f reflect.Value) bool {
+	switch f.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return f.Len() == 0
+	case reflect.Bool:
+		return !f.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return f.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return f.IsNil()
+	}
+	return false
+}
+
+// MarshalCanonical returns the JSON encoding of v in canonical form: object
+// keys are sorted, integers are written exactly, other numbers in their
+// shortest form, and there is no insignificant whitespace or HTML escaping.
+// Equal documents encode to equal bytes, which makes the encoding suitable for
+// storing and diffing files.
+func MarshalCanonical(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decoding numbers as float64 would round integers beyond 2^53, such as
+	// IDs kept in Extra.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	doc, err = canonicalNumbers(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonicalNumbers replaces the numbers in the decoded JSON value v which are
+// not integers by their float64 value, so they are encoded in their shortest
+// form. Integers are kept as written.
+func canonicalNumbers(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			c, err := canonicalNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = c
+		}
+	case []interface{}:
+		for i, e := range v {
+			c, err := canonicalNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = c
+		}
+	case json.Number:
+		if !strings.ContainsAny(string(v), ".eE") {
+			return v, nil
+		}
+		return v.Float64()
+	}
+	return v, nil
+}
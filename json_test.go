@@ -0,0 +1,166 @@
+package figma
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const fileFixture = `{
+	"name": "Checkout <v2>",
+	"version": "1234",
+	"lastModified": "2024-01-02T03:04:05Z",
+	"components": {"1:3": {"name": "Button", "key": "abc", "description": "", "futureFlag": true}},
+	"document": {
+		"type": "DOCUMENT",
+		"id": "0:0",
+		"name": "Document",
+		"children": [{
+			"type": "CANVAS",
+			"id": "0:1",
+			"name": "Page 1",
+			"backgroundColor": {"r": 1, "g": 1, "b": 1, "a": 1},
+			"futureThing": [1.50, 1e2, {"id": 9007199254740993}],
+			"children": [{
+				"type": "RECTANGLE",
+				"id": "1:2",
+				"name": "Box",
+				"visible": false,
+				"fills": [{"type": "SOLID", "color": {"r": 0.5, "g": 0, "b": 0, "a": 1}, "futurePaint": "x"}]
+			}]
+		}]
+	},
+	"futureId": 12345678901234567890
+}`
+
+const fileCanonical = `{"components":{"1:3":{"description":"","futureFlag":true,"key":"abc","name":"Button"}},"document":{"children":[{"backgroundColor":{"a":1,"b":1,"g":1,"r":1},"children":[{"fills":[{"color":{"a":1,"b":0,"g":0,"r":0.5},"futurePaint":"x","type":"SOLID"}],"id":"1:2","name":"Box","type":"RECTANGLE","visible":false}],"futureThing":[1.5,100,{"id":9007199254740993}],"id":"0:1","name":"Page 1","type":"CANVAS"}],"id":"0:0","name":"Document","type":"DOCUMENT"},"futureId":12345678901234567890,"lastModified":"2024-01-02T03:04:05Z","name":"Checkout <v2>","version":"1234"}`
+
+func TestFileRoundTrip(t *testing.T) {
+	var f File
+	if err := json.Unmarshal([]byte(fileFixture), &f); err != nil {
+		t.Fatal(err)
+	}
+
+	page := f.Document.Children[0]
+	rect := page.Children[0]
+	if !page.Visible || page.IsSet("visible") || page.Opacity != 1 {
+		t.Errorf("omitted properties of page not defaulted: visible %v opacity %v", page.Visible, page.Opacity)
+	}
+	if rect.Visible || !rect.IsSet("visible") {
+		t.Errorf("explicit visible of rectangle not kept")
+	}
+	if p := rect.Fills[0]; !p.Visible || p.Opacity != 1 || p.IsSet("opacity") {
+		t.Errorf("omitted properties of paint not defaulted: %+v", p)
+	}
+	if string(f.Extra["futureId"]) != "12345678901234567890" {
+		t.Errorf("unknown file field = %s", f.Extra["futureId"])
+	}
+	if _, ok := page.Extra["futureThing"]; !ok {
+		t.Errorf("unknown node field not kept")
+	}
+
+	b, err := MarshalCanonical(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != fileCanonical {
+		t.Errorf("MarshalCanonical =\n%s\nwant\n%s", b, fileCanonical)
+	}
+
+	// Encoding the decoded canonical form gives the same bytes.
+	var g File
+	if err := json.Unmarshal(b, &g); err != nil {
+		t.Fatal(err)
+	}
+	c, err := MarshalCanonical(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(c) != string(b) {
+		t.Errorf("MarshalCanonical not stable:\n%s\n%s", c, b)
+	}
+}
+
+func TestUnmarshalObjectKeyCase(t *testing.T) {
+	var n Node
+	if err := json.Unmarshal([]byte(`{"ID":"1:2","Type":"FRAME","id_":"x"}`), &n); err != nil {
+		t.Fatal(err)
+	}
+	if n.ID != "1:2" || n.Type != NodeTypeFrame || !n.IsSet("id") {
+		t.Errorf("keys not matched case-insensitively: %+v", n)
+	}
+	if _, ok := n.Extra["id_"]; !ok {
+		t.Errorf("unknown key not kept: %v", n.Extra)
+	}
+}
+
+// caseFields has JSON keys differing only by case.
+type caseFields struct {
+	Lower string `json:"name"`
+	Upper string `json:"NAME"`
+
+	Extra   map[string]json.RawMessage `json:"-"`
+	present fieldSet
+}
+
+func TestUnmarshalObjectFoldOrder(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		var v caseFields
+		if err := unmarshalObject([]byte(`{"Name":"x"}`), &v, &v.present, &v.Extra); err != nil {
+			t.Fatal(err)
+		}
+		if v.Lower != "x" || v.Upper != "" {
+			t.Fatalf("Name matched %+v, want the first field declared", v)
+		}
+	}
+}
+
+func TestMarshalObjectOmitEmpty(t *testing.T) {
+	n := Node{ID: "1:1", Type: NodeTypeFrame, Visible: true}
+	n.Opacity = 1
+	n.Fills = []Paint{}
+	b, err := MarshalCanonical(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":"1:1","type":"FRAME"}`; string(b) != want {
+		t.Errorf("MarshalCanonical = %s, want %s", b, want)
+	}
+
+	// Empty values present in the input are kept.
+	if err := json.Unmarshal([]byte(`{"id":"1:1","type":"FRAME","fills":[],"locked":false}`), &n); err != nil {
+		t.Fatal(err)
+	}
+	b, err = MarshalCanonical(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"fills":[],"id":"1:1","locked":false,"type":"FRAME"}`; string(b) != want {
+		t.Errorf("MarshalCanonical = %s, want %s", b, want)
+	}
+}
+
+func TestUnmarshalObjectNested(t *testing.T) {
+	const depth = 100
+	doc := strings.Repeat(`{"type":"FRAME","children":[`, depth) + `{"type":"TEXT","fills":[null,{"type":"SOLID"}]}` + strings.Repeat(`]}`, depth)
+
+	var n Node
+	if err := json.Unmarshal([]byte(doc), &n); err != nil {
+		t.Fatal(err)
+	}
+	leaf := &n
+	for i := 0; i < depth; i++ {
+		if len(leaf.Children) != 1 || !leaf.Visible {
+			t.Fatalf("level %d not decoded: %+v", i, leaf)
+		}
+		leaf = &leaf.Children[0]
+	}
+	if leaf.Type != NodeTypeText || len(leaf.Fills) != 2 || !leaf.Fills[0].Visible || leaf.Fills[1].Opacity != 1 {
+		t.Errorf("leaf not decoded: %+v", leaf)
+	}
+
+	err := json.Unmarshal([]byte(`{"children":[{"fills":[{"opacity":"x"}]}]}`), &n)
+	if err == nil || !strings.HasPrefix(err.Error(), "children: fills: opacity: ") {
+		t.Errorf("error = %v, want the path to the value", err)
+	}
+}
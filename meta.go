@@ -0,0 +1,43 @@
+package figma
+
+import "time"
+
+type fileMetaResponse struct {
+	File FileMeta `json:"file"`
+}
+
+// FileMeta contains the metadata of a file, without its document.
+type FileMeta struct {
+	// The name of the file
+	Name string `json:"name"`
+
+	// The name of the project containing the file
+	FolderName string `json:"folder_name"`
+
+	// The time at which the file was last modified
+	LastTouchedAt time.Time `json:"last_touched_at"`
+
+	// The user who created the file
+	Creator User `json:"creator"`
+
+	// The user who last modified the file
+	LastTouchedBy User `json:"last_touched_by"`
+
+	// URL of a thumbnail image of the file
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The editor the file was created in
+	EditorType EditorType `json:"editorType"`
+
+	// The role of the authenticated user on the file
+	Role Role `json:"role"`
+
+	// The access level of the file's share link
+	LinkAccess LinkAccess `json:"link_access"`
+
+	// The URL of the file
+	URL string `json:"url"`
+
+	// The version of the file, changes whenever the file is modified
+	Version string `json:"version"`
+}
@@ -1,17 +1,74 @@
 package figma
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // File contains a Figma file https://www.figma.com/file/:key/:title.
 type File struct {
+	// The name of the file as it appears in the editor
+	Name string `json:"name"`
+
+	// The time at which the file was last modified
+	LastModified time.Time `json:"lastModified"`
+
+	// URL of a thumbnail image of the file
+	ThumbnailURL string `json:"thumbnailUrl"`
+
+	// The version of the file, changes whenever the file is modified
+	Version string `json:"version"`
+
+	// The role of the authenticated user on the file
+	Role Role `json:"role"`
+
+	// The editor the file was created in
+	EditorType EditorType `json:"editorType"`
+
+	// The access level of the file's share link
+	LinkAccessPerm LinkAccess `json:"linkAccessPerm"`
+
+	// The key of the file this file is a branch of, if it is a branch
+	MainFileKey string `json:"mainFileKey"`
+
+	// The branches of the file, if requested with WithBranchData
+	Branches []Branch `json:"branches"`
+
 	// A mapping from NodeIDs to component metadata This is to help you
-	// determine which components each instance comes from. Currently the only
-	// piece of metadata available on components is the name of the component,
-	// but more properties will be forthcoming.
-	Components struct {
-	} `json:"components"`
+	// determine which components each instance comes from.
+	Components map[string]Component `json:"components"`
+
+	// A mapping from NodeIDs to component set metadata.
+	ComponentSets map[string]ComponentSet `json:"componentSets"`
+
+	// A mapping from style IDs to style metadata, referenced by the Styles of
+	// nodes.
+	Styles map[string]Style `json:"styles"`
 
 	// A Node of type DOCUMENT.
 	Document      Node `json:"document"`
 	SchemaVersion int  `json:"schemaVersion"`
+
+	// Properties of the file not modeled by this package, kept so the file can
+	// be encoded back to JSON without losing them.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (f *File) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, f, &f.present, &f.Extra)
+}
+
+func (f *File) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, f, &f.present, &f.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface. The encoding is equivalent
+// to the JSON the file was decoded from.
+func (f File) MarshalJSON() ([]byte, error) {
+	return marshalObject(f, f.present, f.Extra)
 }
 
 // Nodes returns a slice containing all subnodes of a Figma file.
@@ -20,7 +77,7 @@ func (f File) Nodes() []Node {
 }
 
 func flatten(n Node) []Node {
-	nodes := n.Children
+	nodes := append([]Node(nil), n.Children...)
 	for i := range nodes {
 		nodes = append(nodes, flatten(nodes[i])...)
 	}
@@ -36,48 +93,124 @@ const (
 	NodeTypeDocument NodeType = "DOCUMENT"
 
 	// NodeTypeCanvas represents a single page
-	NodeTypeCanvas = "CANVAS"
+	NodeTypeCanvas NodeType = "CANVAS"
 
 	// NodeTypeFrame is A node of fixed size containing other nodes
-	NodeTypeFrame = "FRAME"
+	NodeTypeFrame NodeType = "FRAME"
 
 	// NodeTypeGroup is a logical grouping of nodes
-	NodeTypeGroup = "GROUP"
+	NodeTypeGroup NodeType = "GROUP"
+
+	// NodeTypeSection is a region of the canvas used to organize frames
+	NodeTypeSection NodeType = "SECTION"
 
 	// NodeTypeVector is a vector network, consisting of vertices and edges
-	NodeTypeVector = "VECTOR"
+	NodeTypeVector NodeType = "VECTOR"
+
+	// NodeTypeBooleanOperation is a group that has a boolean operation applied
+	// to it
+	NodeTypeBooleanOperation NodeType = "BOOLEAN_OPERATION"
 
 	// NodeTypeBoolean is a group that has a boolean operation applied to it
-	NodeTypeBoolean = "BOOLEAN"
+	//
+	// Deprecated: the API names this type BOOLEAN_OPERATION, use
+	// NodeTypeBooleanOperation instead.
+	NodeTypeBoolean = NodeTypeBooleanOperation
 
 	// NodeTypeStar is a regular star shape
-	NodeTypeStar = "STAR"
+	NodeTypeStar NodeType = "STAR"
 
 	// NodeTypeLine is a straight line
-	NodeTypeLine = "LINE"
+	NodeTypeLine NodeType = "LINE"
 
 	// NodeTypeEllipse is an ellipse
-	NodeTypeEllipse = "ELLIPSE"
+	NodeTypeEllipse NodeType = "ELLIPSE"
 
 	// NodeTypeRegularPolygon is a regular n-sided polygon
-	NodeTypeRegularPolygon = "REGULAR_POLYGON"
+	NodeTypeRegularPolygon NodeType = "REGULAR_POLYGON"
 
 	// NodeTypeRectangle is a rectangle
-	NodeTypeRectangle = "RECTANGLE"
+	NodeTypeRectangle NodeType = "RECTANGLE"
 
 	// NodeTypeText is a text box
-	NodeTypeText = "TEXT"
+	NodeTypeText NodeType = "TEXT"
+
+	// NodeTypeTextPath is text laid out along a vector path
+	NodeTypeTextPath NodeType = "TEXT_PATH"
+
+	// NodeTypeTable is a table of rows and columns of cells
+	NodeTypeTable NodeType = "TABLE"
+
+	// NodeTypeTableCell is a single cell of a table
+	NodeTypeTableCell NodeType = "TABLE_CELL"
 
 	// NodeTypeSlice is a rectangular region of the canvas that can be exported
-	NodeTypeSlice = "SLICE"
+	NodeTypeSlice NodeType = "SLICE"
 
 	// NodeTypeComponent is a node that can have instances created of it that share the same properties
-	NodeTypeComponent = "COMPONENT"
+	NodeTypeComponent NodeType = "COMPONENT"
+
+	// NodeTypeComponentSet is a set of components that are variants of each other
+	NodeTypeComponentSet NodeType = "COMPONENT_SET"
 
 	// NodeTypeInstance is an instance of a component, changes to the component result in the same changes applied to the instance
-	NodeTypeInstance = "INSTANCE"
+	NodeTypeInstance NodeType = "INSTANCE"
+
+	// NodeTypeSticky is a FigJam sticky note
+	NodeTypeSticky NodeType = "STICKY"
+
+	// NodeTypeShapeWithText is a FigJam shape with text inside
+	NodeTypeShapeWithText NodeType = "SHAPE_WITH_TEXT"
+
+	// NodeTypeConnector is a FigJam line connecting two nodes or points
+	NodeTypeConnector NodeType = "CONNECTOR"
+
+	// NodeTypeWashiTape is a FigJam decorative tape
+	NodeTypeWashiTape NodeType = "WASHI_TAPE"
+
+	// NodeTypeEmbed is embedded content from another website
+	NodeTypeEmbed NodeType = "EMBED"
+
+	// NodeTypeLinkUnfurl is a preview of a pasted link
+	NodeTypeLinkUnfurl NodeType = "LINK_UNFURL"
 )
 
+var knownNodeTypes = map[NodeType]bool{
+	NodeTypeDocument:         true,
+	NodeTypeCanvas:           true,
+	NodeTypeFrame:            true,
+	NodeTypeGroup:            true,
+	NodeTypeSection:          true,
+	NodeTypeVector:           true,
+	NodeTypeBooleanOperation: true,
+	NodeTypeStar:             true,
+	NodeTypeLine:             true,
+	NodeTypeEllipse:          true,
+	NodeTypeRegularPolygon:   true,
+	NodeTypeRectangle:        true,
+	NodeTypeText:             true,
+	NodeTypeTextPath:         true,
+	NodeTypeTable:            true,
+	NodeTypeTableCell:        true,
+	NodeTypeSlice:            true,
+	NodeTypeComponent:        true,
+	NodeTypeComponentSet:     true,
+	NodeTypeInstance:         true,
+	NodeTypeSticky:           true,
+	NodeTypeShapeWithText:    true,
+	NodeTypeConnector:        true,
+	NodeTypeWashiTape:        true,
+	NodeTypeEmbed:            true,
+	NodeTypeLinkUnfurl:       true,
+}
+
+// Known reports whether t is one of the node types declared by this package.
+// Nodes of types added to Figma later decode with their type name intact, and
+// can be recognized by Known returning false.
+func (t NodeType) Known() bool {
+	return knownNodeTypes[t]
+}
+
 // Node contains a group of properties which specifies a leaf in a Figma
 // File.
 //
@@ -100,26 +233,308 @@ type Node struct {
 	// Whether or not the node is visible on the canvas. (default: true)
 	Visible bool `json:"visible"`
 
-	Children        []Node           `json:"children"`
-	BackgroundColor Color            `json:"backgroundColor"`
-	ExportSettings  []ExportSetting  `json:"exportSettings"`
-	BlendMode       BlendMode        `json:"blendMode"`
-	PreserveRatio   bool             `json:"preserveRatio"`
-	Constraints     LayoutConstraint `json:"constraints"`
-	LayoutGrids     []LayoutGrid     `json:"layoutGrids"`
-
-	TransitionNodeID    string `json:"transitionNodeID"`
-	Opacity             float64
-	AbsoluteBoundingBox Rectangle `json:"absoluteBoundingBox"`
-	ClipsContent        bool      `json:"clipsContent,omitempty"`
-	Effects             []Effect  `json:"effects"`
-	IsMask              bool
-	Fills               []Paint     `json:"fills,omitempty"`
-	Strokes             []Paint     `json:"strokes,omitempty"`
-	StrokeWeight        int         `json:"strokeWeight,omitempty"`
-	StrokeAlign         StrokeAlign `json:"strokeAlign,omitempty"`
-	Characters          string      `json:"characters,omitempty"`
-	Style               TypeStyle   `json:"style,omitempty"`
+	// Whether the node is locked from editing in the tool.
+	Locked bool `json:"locked,omitempty"`
+
+	Children []Node `json:"children"`
+
+	// The component properties controlling properties of the node, mapped
+	// from "visible", "characters" or "mainComponent" to the property name.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+
+	// Data stored on the node by plugins, mapped by plugin ID and key. Only
+	// returned for the plugins requested with WithPluginData.
+	PluginData map[string]map[string]string `json:"pluginData,omitempty"`
+
+	// Data stored on the node by plugins to share with other plugins, mapped by
+	// namespace and key. Only returned when requested with WithPluginData.
+	SharedPluginData map[string]map[string]string `json:"sharedPluginData,omitempty"`
+
+	// The properties below only apply to some node types, see Typed for the
+	// groups of properties each type of node has.
+	CanvasTraits
+	VariableTraits
+	BackgroundTraits
+	BlendTraits
+	LayoutTraits
+	GeometryTraits
+	StyleTraits
+	CornerTraits
+	FrameTraits
+	AutoLayoutTraits
+	ExportTraits
+	PrototypeTraits
+	DevModeTraits
+	TextTraits
+	ComponentTraits
+	InstanceTraits
+	BooleanOperationTraits
+	EllipseTraits
+	SectionTraits
+	StickyTraits
+	ShapeWithTextTraits
+	ConnectorTraits
+
+	// Properties of the node not modeled by this package, kept so the node can
+	// be encoded back to JSON without losing them.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (n *Node) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, n, &n.present, &n.Extra)
+}
+
+func (n *Node) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, n, &n.present, &n.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface. The encoding is equivalent
+// to the JSON the node was decoded from.
+func (n Node) MarshalJSON() ([]byte, error) {
+	return marshalObject(n, n.present, n.Extra)
+}
+
+func (n *Node) setDefaults() {
+	n.Visible = true
+	n.Opacity = 1
+}
+
+// IsSet reports whether the property with the given JSON name, such as
+// "visible", was present when the node was decoded. Omitted properties hold
+// their default value.
+func (n Node) IsSet(name string) bool {
+	return n.present[name]
+}
+
+// VariableTraits are the properties of nodes with variables bound to them.
+type VariableTraits struct {
+	// The variables bound to properties of the node, mapped by property name,
+	// e.g. "itemSpacing" or "characters".
+	BoundVariables map[string]VariableBinding `json:"boundVariables,omitempty"`
+
+	// The modes of variable collections set on the node, mapped from
+	// collection ID to mode ID. Children inherit the modes of their parents.
+	ExplicitVariableModes map[string]string `json:"explicitVariableModes,omitempty"`
+}
+
+// CanvasTraits are the properties of pages.
+type CanvasTraits struct {
+	// The starting points of the prototype flows of the page.
+	FlowStartingPoints []FlowStartingPoint `json:"flowStartingPoints,omitempty"`
+
+	// The node the prototype of the page starts at.
+	//
+	// Deprecated: use FlowStartingPoints instead.
+	PrototypeStartNodeID string `json:"prototypeStartNodeID,omitempty"`
+
+	// The device the prototype of the page is presented in.
+	PrototypeDevice *PrototypeDevice `json:"prototypeDevice,omitempty"`
+}
+
+// BackgroundTraits are the properties of pages and frames drawn behind their
+// contents.
+type BackgroundTraits struct {
+	BackgroundColor Color `json:"backgroundColor"`
+}
+
+// BlendTraits are the properties of nodes which are composited onto the
+// canvas.
+type BlendTraits struct {
+	BlendMode BlendMode `json:"blendMode"`
+
+	// Opacity of the node (default: 1)
+	Opacity float64  `json:"opacity"`
+	Effects []Effect `json:"effects"`
+	IsMask  bool     `json:"isMask"`
+}
+
+// LayoutTraits are the properties of nodes which are positioned on the canvas.
+type LayoutTraits struct {
+	AbsoluteBoundingBox Rectangle        `json:"absoluteBoundingBox"`
+	Constraints         LayoutConstraint `json:"constraints"`
+	PreserveRatio       bool             `json:"preserveRatio"`
+
+	// The bounds of the rendered node including effects and strokes, nil if
+	// the node is not rendered.
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+
+	// The position, rotation and skew of the node relative to its parent.
+	RelativeTransform Transform `json:"relativeTransform,omitempty"`
+
+	// The width and height of the node, before the transform is applied.
+	Size Vector `json:"size,omitempty"`
+
+	// The properties below apply to children of auto layout frames.
+
+	// How the node is aligned on the counter axis of the auto layout.
+	LayoutAlign LayoutAlign `json:"layoutAlign,omitempty"`
+
+	// Whether the node stretches along the primary axis of the auto layout, 1
+	// if it does and 0 if it does not.
+	LayoutGrow float64 `json:"layoutGrow,omitempty"`
+
+	// Whether the node is positioned by the auto layout or absolutely within
+	// the frame.
+	LayoutPositioning LayoutPositioning `json:"layoutPositioning,omitempty"`
+
+	// How the node is sized horizontally and vertically by the auto layout.
+	LayoutSizingHorizontal LayoutSizing `json:"layoutSizingHorizontal,omitempty"`
+	LayoutSizingVertical   LayoutSizing `json:"layoutSizingVertical,omitempty"`
+
+	// The limits of the size of the node, nil when unlimited.
+	MinWidth  *float64 `json:"minWidth,omitempty"`
+	MaxWidth  *float64 `json:"maxWidth,omitempty"`
+	MinHeight *float64 `json:"minHeight,omitempty"`
+	MaxHeight *float64 `json:"maxHeight,omitempty"`
+}
+
+// GeometryTraits are the properties of nodes which have fills and strokes.
+type GeometryTraits struct {
+	Fills        []Paint     `json:"fills,omitempty"`
+	Strokes      []Paint     `json:"strokes,omitempty"`
+	StrokeWeight float64     `json:"strokeWeight,omitempty"`
+	StrokeAlign  StrokeAlign `json:"strokeAlign,omitempty"`
+
+	// The weight of each side of the stroke of a rectangular node, if they
+	// differ.
+	IndividualStrokeWeights *StrokeWeights `json:"individualStrokeWeights,omitempty"`
+
+	// Alternating lengths of dashes and gaps of a dashed stroke.
+	StrokeDashes []float64 `json:"strokeDashes,omitempty"`
+
+	// The decoration of the ends of open paths.
+	StrokeCap StrokeCap `json:"strokeCap,omitempty"`
+
+	// How the corners of the stroke are joined.
+	StrokeJoin StrokeJoin `json:"strokeJoin,omitempty"`
+
+	// The angle in degrees below which miter joins are beveled.
+	StrokeMiterAngle float64 `json:"strokeMiterAngle,omitempty"`
+
+	// The outlines of the fill and of the stroke of the node, only present if
+	// the file was requested with WithGeometryPaths.
+	FillGeometry   []Path `json:"fillGeometry,omitempty"`
+	StrokeGeometry []Path `json:"strokeGeometry,omitempty"`
+}
+
+// StyleTraits are the properties of nodes which can have styles applied.
+type StyleTraits struct {
+	// The styles applied to the node, mapped from the kind of property they
+	// apply to, e.g. "fill", "stroke", "text", "effect" or "grid", to the
+	// style ID in the styles table of the file.
+	Styles map[string]string `json:"styles,omitempty"`
+}
+
+// CornerTraits are the properties of nodes with rounded corners.
+type CornerTraits struct {
+	// Radius of each corner of the node.
+	CornerRadius float64 `json:"cornerRadius,omitempty"`
+
+	// Radius of the top left, top right, bottom right and bottom left corners,
+	// if they differ.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+
+	// How smooth the corners are, between 0 and 1, 0.6 matching iOS.
+	CornerSmoothing float64 `json:"cornerSmoothing,omitempty"`
+}
+
+// FrameTraits are the properties of frames and other nodes containing a
+// layout of children.
+type FrameTraits struct {
+	ClipsContent bool         `json:"clipsContent,omitempty"`
+	LayoutGrids  []LayoutGrid `json:"layoutGrids"`
+}
+
+// AutoLayoutTraits are the properties of frames which lay out their children
+// automatically, such as a flexbox or stack would.
+type AutoLayoutTraits struct {
+	// The direction children are laid out in, or NONE if auto layout is
+	// disabled.
+	LayoutMode LayoutMode `json:"layoutMode,omitempty"`
+
+	// Whether children wrap onto new lines when they overflow the frame.
+	LayoutWrap LayoutWrap `json:"layoutWrap,omitempty"`
+
+	// Whether the frame's size on each axis is fixed or hugs its children.
+	PrimaryAxisSizingMode AxisSizingMode `json:"primaryAxisSizingMode,omitempty"`
+	CounterAxisSizingMode AxisSizingMode `json:"counterAxisSizingMode,omitempty"`
+
+	// How children are aligned on each axis.
+	PrimaryAxisAlignItems PrimaryAxisAlignItems `json:"primaryAxisAlignItems,omitempty"`
+	CounterAxisAlignItems CounterAxisAlignItems `json:"counterAxisAlignItems,omitempty"`
+
+	// How wrapped lines are distributed on the counter axis.
+	CounterAxisAlignContent CounterAxisAlignContent `json:"counterAxisAlignContent,omitempty"`
+
+	// Space between the frame's edges and its children in px.
+	PaddingLeft   float64 `json:"paddingLeft,omitempty"`
+	PaddingRight  float64 `json:"paddingRight,omitempty"`
+	PaddingTop    float64 `json:"paddingTop,omitempty"`
+	PaddingBottom float64 `json:"paddingBottom,omitempty"`
+
+	// Space between children on the primary axis in px.
+	ItemSpacing float64 `json:"itemSpacing,omitempty"`
+
+	// Space between wrapped lines on the counter axis in px.
+	CounterAxisSpacing float64 `json:"counterAxisSpacing,omitempty"`
+
+	// Whether the first child is drawn on top of the following ones.
+	ItemReverseZIndex bool `json:"itemReverseZIndex,omitempty"`
+
+	// Whether strokes are included when laying out children.
+	StrokesIncludedInLayout bool `json:"strokesIncludedInLayout,omitempty"`
+}
+
+// ExportTraits are the properties of nodes which can be exported.
+type ExportTraits struct {
+	ExportSettings []ExportSetting `json:"exportSettings"`
+}
+
+// DevModeTraits are the properties of nodes used in Dev Mode.
+type DevModeTraits struct {
+	// Whether the node is ready for development or completed, set on frames,
+	// sections and components.
+	DevStatus *DevStatus `json:"devStatus,omitempty"`
+
+	// The notes and pinned properties annotating the node.
+	Annotations []Annotation `json:"annotations,omitempty"`
+}
+
+// PrototypeTraits are the properties of nodes which take part in prototypes.
+type PrototypeTraits struct {
+	// The interactions of the node with their triggers and actions.
+	Reactions []Reaction `json:"reactions,omitempty"`
+
+	// The node navigated to when the node is clicked.
+	//
+	// Deprecated: use Reactions instead.
+	TransitionNodeID   string     `json:"transitionNodeID"`
+	TransitionDuration float64    `json:"transitionDuration,omitempty"`
+	TransitionEasing   EasingType `json:"transitionEasing,omitempty"`
+
+	// The direction the frame scrolls in when its contents overflow.
+	OverflowDirection OverflowDirection `json:"overflowDirection,omitempty"`
+
+	// How the node moves when its parent frame scrolls.
+	ScrollBehavior ScrollBehavior `json:"scrollBehavior,omitempty"`
+
+	// The placement of the frame when it is opened as an overlay.
+	OverlayPositionType OverlayPositionType `json:"overlayPositionType,omitempty"`
+
+	// The background drawn behind the frame when it is opened as an overlay.
+	OverlayBackground *OverlayBackground `json:"overlayBackground,omitempty"`
+
+	// How clicks on the overlay background are handled.
+	OverlayBackgroundInteraction OverlayBackgroundInteraction `json:"overlayBackgroundInteraction,omitempty"`
+}
+
+// TextTraits are the properties of nodes which contain text.
+type TextTraits struct {
+	Characters string    `json:"characters,omitempty"`
+	Style      TypeStyle `json:"style,omitempty"`
 
 	// Array with same number of elements as characeters in text box, each
 	// element is a reference to the styleOverrideTable defined below and maps
@@ -127,9 +542,96 @@ type Node struct {
 	// value 0 have the default type style.
 	CharacterStyleOverrides []int `json:"characterStyleOverrides,omitempty"`
 
-	// Map from ID to TypeStyle for looking up style overrides.
-	StyleOverrideTable map[int]TypeStyle `json:"styleOverrideTable,omitempty"`
+	// Map from ID to TypeStyle for looking up style overrides. The styles only
+	// contain the properties which differ from the style of the node.
+	StyleOverrideTable map[string]TypeStyle `json:"styleOverrideTable,omitempty"`
 
+	// The list style of each line of text.
+	LineTypes []LineType `json:"lineTypes,omitempty"`
+
+	// The indentation level of each line of text, used for nested lists.
+	LineIndentations []int `json:"lineIndentations,omitempty"`
+}
+
+// ComponentTraits are the properties of components and component sets.
+type ComponentTraits struct {
+	// The properties instances of the component can set, mapped by property
+	// name. The properties of variants are defined on their component set.
+	ComponentPropertyDefinitions map[string]ComponentPropertyDefinition `json:"componentPropertyDefinitions,omitempty"`
+}
+
+// InstanceTraits are the properties of component instances.
+type InstanceTraits struct {
 	// ID of component that this instance came from, refers to components table.
-	ComponentID string
+	ComponentID string `json:"componentId"`
+
+	// The values of the component properties of the instance, mapped by
+	// property name.
+	ComponentProperties map[string]ComponentProperty `json:"componentProperties,omitempty"`
+
+	// The properties of the instance and its children which differ from the
+	// component.
+	Overrides []Overrides `json:"overrides,omitempty"`
+
+	// Whether the instance is a nested instance exposed on its containing
+	// instance.
+	IsExposedInstance bool `json:"isExposedInstance,omitempty"`
+
+	// IDs of the nested instances exposed on the instance.
+	ExposedInstances []string `json:"exposedInstances,omitempty"`
+}
+
+// BooleanOperationTraits are the properties of boolean operation nodes.
+type BooleanOperationTraits struct {
+	// The boolean operation applied to the children.
+	BooleanOperation BooleanOperation `json:"booleanOperation,omitempty"`
+}
+
+// EllipseTraits are the properties of ellipses.
+type EllipseTraits struct {
+	// The arc of the ellipse, for partial ellipses and rings.
+	ArcData *ArcData `json:"arcData,omitempty"`
+}
+
+// SectionTraits are the properties of sections.
+type SectionTraits struct {
+	// Whether the contents of the section are hidden.
+	SectionContentsHidden bool `json:"sectionContentsHidden,omitempty"`
+}
+
+// StickyTraits are the properties of FigJam stickies.
+type StickyTraits struct {
+	// Whether the author of the sticky is shown.
+	AuthorVisible bool `json:"authorVisible,omitempty"`
+}
+
+// ShapeWithTextTraits are the properties of FigJam shapes with text.
+type ShapeWithTextTraits struct {
+	// The shape drawn around the text.
+	ShapeType ShapeType `json:"shapeType,omitempty"`
+}
+
+// ConnectorTraits are the properties of FigJam connectors.
+type ConnectorTraits struct {
+	// The endpoints of the connector.
+	ConnectorStart ConnectorEndpoint `json:"connectorStart,omitempty"`
+	ConnectorEnd   ConnectorEndpoint `json:"connectorEnd,omitempty"`
+
+	// The decorations drawn at the endpoints.
+	ConnectorStartStrokeCap StrokeCap `json:"connectorStartStrokeCap,omitempty"`
+	ConnectorEndStrokeCap   StrokeCap `json:"connectorEndStrokeCap,omitempty"`
+
+	// How the line of the connector is drawn.
+	ConnectorLineType ConnectorLineType `json:"connectorLineType,omitempty"`
+
+	// The background behind the text of the connector.
+	TextBackground *ConnectorTextBackground `json:"textBackground,omitempty"`
+}
+
+// walk calls fn for n and each of its descendants, depth first.
+func walk(n *Node, fn func(*Node)) {
+	fn(n)
+	for i := range n.Children {
+		walk(&n.Children[i], fn)
+	}
 }
@@ -0,0 +1,49 @@
+package figma
+
+import "time"
+
+// FileNodes are nodes exported from a file, see Client.FileNodes.
+type FileNodes struct {
+	// The name of the file as it appears in the editor
+	Name string `json:"name"`
+
+	// The time at which the file was last modified
+	LastModified time.Time `json:"lastModified"`
+
+	// URL of a thumbnail image of the file
+	ThumbnailURL string `json:"thumbnailUrl"`
+
+	// The version of the file, changes whenever the file is modified
+	Version string `json:"version"`
+
+	// The role of the authenticated user on the file
+	Role Role `json:"role"`
+
+	// The editor the file was created in
+	EditorType EditorType `json:"editorType"`
+
+	// The access level of the file's share link
+	LinkAccessPerm LinkAccess `json:"linkAccessPerm"`
+
+	// The requested nodes mapped by ID. The value is nil for IDs which do not
+	// exist in the file.
+	Nodes map[string]*FileNode `json:"nodes"`
+}
+
+// FileNode is a node exported from a file with the metadata of the
+// components and styles used within it.
+type FileNode struct {
+	// The node and its descendants
+	Document Node `json:"document"`
+
+	// A mapping from NodeIDs to component metadata.
+	Components map[string]Component `json:"components"`
+
+	// A mapping from NodeIDs to component set metadata.
+	ComponentSets map[string]ComponentSet `json:"componentSets"`
+
+	// A mapping from style IDs to style metadata.
+	Styles map[string]Style `json:"styles"`
+
+	SchemaVersion int `json:"schemaVersion"`
+}
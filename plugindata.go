@@ -0,0 +1,70 @@
+package figma
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GetPluginData returns the value stored under key by the plugin with the
+// given ID, and reports whether there is one.
+func (n *Node) GetPluginData(pluginID, key string) (string, bool) {
+	v, ok := n.PluginData[pluginID][key]
+	return v, ok
+}
+
+// GetSharedPluginData returns the value shared under key in the namespace,
+// and reports whether there is one.
+func (n *Node) GetSharedPluginData(namespace, key string) (string, bool) {
+	v, ok := n.SharedPluginData[namespace][key]
+	return v, ok
+}
+
+// DecodePluginData decodes the JSON value stored under key by the plugin with
+// the given ID into v.
+func (n *Node) DecodePluginData(pluginID, key string, v interface{}) error {
+	s, ok := n.GetPluginData(pluginID, key)
+	if !ok {
+		return fmt.Errorf("%s: no plugin data %s for plugin %s", n.ID, key, pluginID)
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return fmt.Errorf("%s: plugin data %s: %s", n.ID, key, err)
+	}
+	return nil
+}
+
+// DecodeSharedPluginData decodes the JSON value shared under key in the
+// namespace into v.
+func (n *Node) DecodeSharedPluginData(namespace, key string, v interface{}) error {
+	s, ok := n.GetSharedPluginData(namespace, key)
+	if !ok {
+		return fmt.Errorf("%s: no shared plugin data %s in namespace %s", n.ID, key, namespace)
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return fmt.Errorf("%s: shared plugin data %s: %s", n.ID, key, err)
+	}
+	return nil
+}
+
+// NodesWithPluginData returns the nodes below root, root included, which
+// carry a value under key stored by the plugin with the given ID.
+func NodesWithPluginData(root *Node, pluginID, key string) []*Node {
+	var res []*Node
+	walk(root, func(n *Node) {
+		if _, ok := n.GetPluginData(pluginID, key); ok {
+			res = append(res, n)
+		}
+	})
+	return res
+}
+
+// NodesWithSharedPluginData returns the nodes below root, root included,
+// which carry a value shared under key in the namespace.
+func NodesWithSharedPluginData(root *Node, namespace, key string) []*Node {
+	var res []*Node
+	walk(root, func(n *Node) {
+		if _, ok := n.GetSharedPluginData(namespace, key); ok {
+			res = append(res, n)
+		}
+	})
+	return res
+}
@@ -1,5 +1,7 @@
 package figma
 
+import "time"
+
 type teamProjectsResponse struct {
 	Projects []TeamProject `json:"projects"`
 }
@@ -12,3 +14,25 @@ type TeamProject struct {
 	// The Name of the project
 	Name string `json:"name"`
 }
+
+type projectFilesResponse struct {
+	Files []ProjectFile `json:"files"`
+}
+
+// ProjectFile is a file which belongs to a project.
+type ProjectFile struct {
+	// The key of the file
+	Key string `json:"key"`
+
+	// The name of the file
+	Name string `json:"name"`
+
+	// URL of a thumbnail image of the file
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The time at which the file was last modified
+	LastModified time.Time `json:"last_modified"`
+
+	// The branches of the file
+	Branches []Branch `json:"branches"`
+}
@@ -0,0 +1,189 @@
+package figma
+
+import "strings"
+
+// ComponentPropertyType specifies the kind of value of a component property.
+type ComponentPropertyType string
+
+const (
+	ComponentPropertyTypeBoolean      ComponentPropertyType = "BOOLEAN"
+	ComponentPropertyTypeInstanceSwap ComponentPropertyType = "INSTANCE_SWAP"
+	ComponentPropertyTypeText         ComponentPropertyType = "TEXT"
+	ComponentPropertyTypeVariant      ComponentPropertyType = "VARIANT"
+)
+
+// ComponentPropertyDefinition is a property instances of a component can set.
+type ComponentPropertyDefinition struct {
+	Type ComponentPropertyType `json:"type"`
+
+	// The value of the property in instances which do not set it: a bool for
+	// BOOLEAN properties, otherwise a string
+	DefaultValue interface{} `json:"defaultValue"`
+
+	// The options of VARIANT properties
+	VariantOptions []string `json:"variantOptions,omitempty"`
+
+	// The components suggested for INSTANCE_SWAP properties
+	PreferredValues []InstanceSwapPreferredValue `json:"preferredValues,omitempty"`
+}
+
+// ComponentProperty is the value of a component property of an instance.
+type ComponentProperty struct {
+	Type ComponentPropertyType `json:"type"`
+
+	// The value of the property: a bool for BOOLEAN properties, the node ID of
+	// a component for INSTANCE_SWAP properties, otherwise a string
+	Value interface{} `json:"value"`
+
+	// The components suggested for INSTANCE_SWAP properties
+	PreferredValues []InstanceSwapPreferredValue `json:"preferredValues,omitempty"`
+
+	// Variables bound to the value of the property
+	BoundVariables map[string]VariableAlias `json:"boundVariables,omitempty"`
+}
+
+// Bool returns the value of a BOOLEAN property.
+func (p ComponentProperty) Bool() (bool, bool) {
+	if p.Type != ComponentPropertyTypeBoolean {
+		return false, false
+	}
+	b, ok := p.Value.(bool)
+	return b, ok
+}
+
+// Text returns the value of a TEXT property.
+func (p ComponentProperty) Text() (string, bool) {
+	return p.stringValue(ComponentPropertyTypeText)
+}
+
+// Variant returns the selected option of a VARIANT property.
+func (p ComponentProperty) Variant() (string, bool) {
+	return p.stringValue(ComponentPropertyTypeVariant)
+}
+
+// InstanceSwap returns the node ID of the component an INSTANCE_SWAP property
+// swaps in, which refers to the components table of the file.
+func (p ComponentProperty) InstanceSwap() (string, bool) {
+	return p.stringValue(ComponentPropertyTypeInstanceSwap)
+}
+
+func (p ComponentProperty) stringValue(t ComponentPropertyType) (string, bool) {
+	if p.Type != t {
+		return "", false
+	}
+	s, ok := p.Value.(string)
+	return s, ok
+}
+
+// InstanceSwapPreferredValue is a component suggested for an INSTANCE_SWAP
+// property.
+type InstanceSwapPreferredValue struct {
+	// COMPONENT or COMPONENT_SET
+	Type string `json:"type"`
+
+	// The key of the component or component set
+	Key string `json:"key"`
+}
+
+// Overrides lists the properties of a node within an instance which differ
+// from the component.
+type Overrides struct {
+	// The ID of the overridden node
+	ID string `json:"id"`
+
+	// The names of the overridden properties, e.g. "characters" or "fills"
+	OverriddenFields []string `json:"overriddenFields"`
+}
+
+// PropertyName returns the name of a component property as shown in the
+// editor. Property names other than variants end with a unique suffix, e.g.
+// "Label#12:0", which PropertyName removes. Other uses of "#", such as in the
+// variant property "Color#1", are kept.
+func PropertyName(name string) string {
+	if i := strings.LastIndex(name, "#"); i > 0 && isPropertyID(name[i+1:]) {
+		return name[:i]
+	}
+	return name
+}
+
+// isPropertyID reports whether s is the unique suffix of a property name,
+// two numbers separated by a colon.
+func isPropertyID(s string) bool {
+	i := strings.IndexByte(s, ':')
+	return i > 0 && isDigits(s[:i]) && isDigits(s[i+1:])
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// InstanceProperties returns the effective values of the component properties
+// of the instance n, mapped by property name. Properties the instance does not
+// set have the default value of their definition, if the component is part of
+// the file.
+func (f *File) InstanceProperties(n *Node) map[string]ComponentProperty {
+	res := make(map[string]ComponentProperty)
+
+	for name, def := range f.propertyDefinitions(n.ComponentID) {
+		res[name] = ComponentProperty{
+			Type:            def.Type,
+			Value:           def.DefaultValue,
+			PreferredValues: def.PreferredValues,
+		}
+	}
+	for name, p := range n.ComponentProperties {
+		res[name] = p
+	}
+
+	return res
+}
+
+// propertyDefinitions returns the property definitions of the component with
+// the given node ID, including those of its component set.
+func (f *File) propertyDefinitions(id string) map[string]ComponentPropertyDefinition {
+	defs := make(map[string]ComponentPropertyDefinition)
+	if id == "" {
+		return defs
+	}
+
+	var ids []string
+	if c, ok := f.Components[id]; ok && c.ComponentSetID != "" {
+		ids = append(ids, c.ComponentSetID)
+	}
+	ids = append(ids, id)
+
+	for _, id := range ids {
+		if n := f.NodeByID(id); n != nil {
+			for name, def := range n.ComponentPropertyDefinitions {
+				defs[name] = def
+			}
+		}
+	}
+	return defs
+}
+
+// NodeByID returns the node of the document with the given ID, or nil if there
+// is none.
+func (f *File) NodeByID(id string) *Node {
+	return findNode(&f.Document, id)
+}
+
+func findNode(n *Node, id string) *Node {
+	if n.ID == id {
+		return n
+	}
+	for i := range n.Children {
+		if res := findNode(&n.Children[i], id); res != nil {
+			return res
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package figma
+
+import "testing"
+
+func TestPropertyName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Label#12:0", "Label"},
+		{"Has icon#1234:56", "Has icon"},
+		{"Size", "Size"},
+		{"Color#1", "Color#1"},
+		{"Tab #2#3:4", "Tab #2"},
+		{"#1:2", "#1:2"},
+		{"Step#1:", "Step#1:"},
+		{"Step#a:1", "Step#a:1"},
+	}
+
+	for _, tt := range tests {
+		if got := PropertyName(tt.name); got != tt.want {
+			t.Errorf("PropertyName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,450 @@
+package figma
+
+import "encoding/json"
+
+// Reaction is an interaction of a prototype: a trigger and the actions it
+// performs.
+type Reaction struct {
+	// The user input which triggers the actions
+	Trigger *Trigger `json:"trigger"`
+
+	// The actions performed, in order
+	Actions []Action `json:"actions,omitempty"`
+
+	// The action performed.
+	//
+	// Deprecated: use Actions instead.
+	Action *Action `json:"action,omitempty"`
+}
+
+// AllActions returns the actions of the reaction, including the deprecated
+// single action of reactions from older files.
+func (r Reaction) AllActions() []Action {
+	if len(r.Actions) == 0 && r.Action != nil {
+		return []Action{*r.Action}
+	}
+	return r.Actions
+}
+
+// TriggerType specifies the user input which triggers a reaction.
+type TriggerType string
+
+const (
+	TriggerTypeOnClick      TriggerType = "ON_CLICK"
+	TriggerTypeOnHover      TriggerType = "ON_HOVER"
+	TriggerTypeOnPress      TriggerType = "ON_PRESS"
+	TriggerTypeOnDrag       TriggerType = "ON_DRAG"
+	TriggerTypeAfterTimeout TriggerType = "AFTER_TIMEOUT"
+	TriggerTypeMouseEnter   TriggerType = "MOUSE_ENTER"
+	TriggerTypeMouseLeave   TriggerType = "MOUSE_LEAVE"
+	TriggerTypeMouseUp      TriggerType = "MOUSE_UP"
+	TriggerTypeMouseDown    TriggerType = "MOUSE_DOWN"
+	TriggerTypeOnKeyDown    TriggerType = "ON_KEY_DOWN"
+	TriggerTypeOnMediaHit   TriggerType = "ON_MEDIA_HIT"
+	TriggerTypeOnMediaEnd   TriggerType = "ON_MEDIA_END"
+)
+
+// Trigger is the user input which triggers a reaction.
+type Trigger struct {
+	Type TriggerType `json:"type"`
+
+	// Seconds before the actions are performed, for AFTER_TIMEOUT triggers
+	Timeout float64 `json:"timeout,omitempty"`
+
+	// Seconds the pointer must stay before the actions are performed, for
+	// MOUSE_ triggers
+	Delay float64 `json:"delay,omitempty"`
+
+	// The input device and keys which trigger ON_KEY_DOWN triggers
+	Device   string `json:"device,omitempty"`
+	KeyCodes []int  `json:"keyCodes,omitempty"`
+
+	// The time in the media at which ON_MEDIA_HIT triggers fire
+	MediaHitTime float64 `json:"mediaHitTime,omitempty"`
+}
+
+// ActionType specifies what an action does.
+type ActionType string
+
+const (
+	ActionTypeBack               ActionType = "BACK"
+	ActionTypeClose              ActionType = "CLOSE"
+	ActionTypeURL                ActionType = "URL"
+	ActionTypeNode               ActionType = "NODE"
+	ActionTypeUpdateMediaRuntime ActionType = "UPDATE_MEDIA_RUNTIME"
+	ActionTypeSetVariable        ActionType = "SET_VARIABLE"
+	ActionTypeSetVariableMode    ActionType = "SET_VARIABLE_MODE"
+	ActionTypeConditional        ActionType = "CONDITIONAL"
+)
+
+// Action is performed when a reaction is triggered.
+type Action struct {
+	Type ActionType `json:"type"`
+
+	// For URL actions: the URL opened, and whether it opens in a new tab
+	URL          string `json:"url,omitempty"`
+	OpenInNewTab bool   `json:"openInNewTab,omitempty"`
+
+	// For NODE actions: the node navigated to, and how
+	DestinationID string      `json:"destinationId,omitempty"`
+	Navigation    Navigation  `json:"navigation,omitempty"`
+	Transition    *Transition `json:"transition,omitempty"`
+
+	// For NODE actions: whether the scroll position of the current frame is
+	// kept, and the position of MANUAL overlays relative to the node
+	PreserveScrollPosition  bool    `json:"preserveScrollPosition,omitempty"`
+	OverlayRelativePosition *Vector `json:"overlayRelativePosition,omitempty"`
+
+	// For NODE actions: what is reset when navigating
+	ResetVideoPosition         bool `json:"resetVideoPosition,omitempty"`
+	ResetScrollPosition        bool `json:"resetScrollPosition,omitempty"`
+	ResetInteractiveComponents bool `json:"resetInteractiveComponents,omitempty"`
+
+	// For SET_VARIABLE and SET_VARIABLE_MODE actions: the variable or
+	// collection changed
+	VariableID           string `json:"variableId,omitempty"`
+	VariableCollectionID string `json:"variableCollectionId,omitempty"`
+	VariableModeID       string `json:"variableModeId,omitempty"`
+
+	// For CONDITIONAL actions: the blocks of actions, of which the actions of
+	// the first block whose condition holds are performed
+	ConditionalBlocks []ConditionalBlock `json:"conditionalBlocks,omitempty"`
+}
+
+// ConditionalBlock is a branch of a CONDITIONAL action.
+type ConditionalBlock struct {
+	// The condition of the block, nil for the final else block
+	Condition *VariableData `json:"condition,omitempty"`
+
+	// The actions performed if the condition holds
+	Actions []Action `json:"actions"`
+}
+
+// VariableData is a value or expression used by a prototype action.
+type VariableData struct {
+	// The type the value or expression resolves to
+	ResolvedType VariableResolvedType `json:"resolvedType,omitempty"`
+
+	// The type of the value: BOOLEAN, FLOAT, STRING, COLOR, VARIABLE_ALIAS or
+	// EXPRESSION
+	DataType string `json:"dataType,omitempty"`
+
+	// The value or expression as sent by the API
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// AllActions returns the action followed by the actions nested in the blocks
+// of CONDITIONAL actions, recursively.
+func (a Action) AllActions() []Action {
+	res := []Action{a}
+	for _, b := range a.ConditionalBlocks {
+		for _, n := range b.Actions {
+			res = append(res, n.AllActions()...)
+		}
+	}
+	return res
+}
+
+// Navigation specifies how a NODE action navigates to its destination.
+type Navigation string
+
+const (
+	NavigationNavigate Navigation = "NAVIGATE"
+	NavigationSwap     Navigation = "SWAP"
+	NavigationOverlay  Navigation = "OVERLAY"
+	NavigationScrollTo Navigation = "SCROLL_TO"
+	NavigationChangeTo Navigation = "CHANGE_TO"
+)
+
+// TransitionType specifies the animation of a transition.
+type TransitionType string
+
+const (
+	TransitionTypeDissolve      TransitionType = "DISSOLVE"
+	TransitionTypeSmartAnimate  TransitionType = "SMART_ANIMATE"
+	TransitionTypeScrollAnimate TransitionType = "SCROLL_ANIMATE"
+	TransitionTypeMoveIn        TransitionType = "MOVE_IN"
+	TransitionTypeMoveOut       TransitionType = "MOVE_OUT"
+	TransitionTypePush          TransitionType = "PUSH"
+	TransitionTypeSlideIn       TransitionType = "SLIDE_IN"
+	TransitionTypeSlideOut      TransitionType = "SLIDE_OUT"
+)
+
+// Transition is the animation from one node to another.
+type Transition struct {
+	Type TransitionType `json:"type"`
+
+	// How the animation progresses over time
+	Easing Easing `json:"easing"`
+
+	// Duration of the animation in seconds
+	Duration float64 `json:"duration"`
+
+	// The direction of MOVE_, PUSH and SLIDE_ transitions
+	Direction TransitionDirection `json:"direction,omitempty"`
+
+	// Whether layers with the same name are animated between, for MOVE_, PUSH
+	// and SLIDE_ transitions
+	MatchLayers bool `json:"matchLayers,omitempty"`
+}
+
+// TransitionDirection specifies the direction of a transition.
+type TransitionDirection string
+
+const (
+	TransitionDirectionLeft   TransitionDirection = "LEFT"
+	TransitionDirectionRight  TransitionDirection = "RIGHT"
+	TransitionDirectionTop    TransitionDirection = "TOP"
+	TransitionDirectionBottom TransitionDirection = "BOTTOM"
+)
+
+// EasingType specifies the easing curve of a transition.
+type EasingType string
+
+const (
+	EasingTypeEaseIn            EasingType = "EASE_IN"
+	EasingTypeEaseOut           EasingType = "EASE_OUT"
+	EasingTypeEaseInAndOut      EasingType = "EASE_IN_AND_OUT"
+	EasingTypeLinear            EasingType = "LINEAR"
+	EasingTypeEaseInBack        EasingType = "EASE_IN_BACK"
+	EasingTypeEaseOutBack       EasingType = "EASE_OUT_BACK"
+	EasingTypeEaseInAndOutBack  EasingType = "EASE_IN_AND_OUT_BACK"
+	EasingTypeCustomCubicBezier EasingType = "CUSTOM_CUBIC_BEZIER"
+	EasingTypeGentle            EasingType = "GENTLE"
+	EasingTypeQuick             EasingType = "QUICK"
+	EasingTypeBouncy            EasingType = "BOUNCY"
+	EasingTypeSlow              EasingType = "SLOW"
+	EasingTypeCustomSpring      EasingType = "CUSTOM_SPRING"
+)
+
+// Easing describes how a transition progresses over time.
+type Easing struct {
+	Type EasingType `json:"type"`
+
+	// The curve of CUSTOM_CUBIC_BEZIER easings
+	EasingFunctionCubicBezier *CubicBezier `json:"easingFunctionCubicBezier,omitempty"`
+
+	// The spring of CUSTOM_SPRING easings
+	EasingFunctionSpring *Spring `json:"easingFunctionSpring,omitempty"`
+}
+
+// CubicBezier is a cubic bezier curve defined by its two control points.
+type CubicBezier struct {
+	X1 float64 `json:"x1"`
+	Y1 float64 `json:"y1"`
+	X2 float64 `json:"x2"`
+	Y2 float64 `json:"y2"`
+}
+
+// Spring describes the physics of a spring animation.
+type Spring struct {
+	Mass      float64 `json:"mass"`
+	Stiffness float64 `json:"stiffness"`
+	Damping   float64 `json:"damping"`
+}
+
+// OverflowDirection specifies the direction a frame scrolls in.
+type OverflowDirection string
+
+const (
+	OverflowDirectionNone                  OverflowDirection = "NONE"
+	OverflowDirectionHorizontal            OverflowDirection = "HORIZONTAL_SCROLLING"
+	OverflowDirectionVertical              OverflowDirection = "VERTICAL_SCROLLING"
+	OverflowDirectionHorizontalAndVertical OverflowDirection = "HORIZONTAL_AND_VERTICAL_SCROLLING"
+)
+
+// ScrollBehavior specifies how a node moves when its parent frame scrolls.
+type ScrollBehavior string
+
+const (
+	ScrollBehaviorScrolls       ScrollBehavior = "SCROLLS"
+	ScrollBehaviorFixed         ScrollBehavior = "FIXED"
+	ScrollBehaviorStickyScrolls ScrollBehavior = "STICKY_SCROLLS"
+)
+
+// OverlayPositionType specifies where an overlay is placed.
+type OverlayPositionType string
+
+const (
+	OverlayPositionTypeCenter       OverlayPositionType = "CENTER"
+	OverlayPositionTypeTopLeft      OverlayPositionType = "TOP_LEFT"
+	OverlayPositionTypeTopCenter    OverlayPositionType = "TOP_CENTER"
+	OverlayPositionTypeTopRight     OverlayPositionType = "TOP_RIGHT"
+	OverlayPositionTypeBottomLeft   OverlayPositionType = "BOTTOM_LEFT"
+	OverlayPositionTypeBottomCenter OverlayPositionType = "BOTTOM_CENTER"
+	OverlayPositionTypeBottomRight  OverlayPositionType = "BOTTOM_RIGHT"
+	OverlayPositionTypeManual       OverlayPositionType = "MANUAL"
+)
+
+// OverlayBackground is the background drawn behind an overlay.
+type OverlayBackground struct {
+	// NONE or SOLID_COLOR
+	Type string `json:"type"`
+
+	// The color of SOLID_COLOR backgrounds
+	Color *Color `json:"color,omitempty"`
+}
+
+// OverlayBackgroundInteraction specifies how clicks on the background of an
+// overlay are handled.
+type OverlayBackgroundInteraction string
+
+const (
+	OverlayBackgroundInteractionNone                OverlayBackgroundInteraction = "NONE"
+	OverlayBackgroundInteractionCloseOnClickOutside OverlayBackgroundInteraction = "CLOSE_ON_CLICK_OUTSIDE"
+)
+
+// FlowStartingPoint is the start of a prototype flow.
+type FlowStartingPoint struct {
+	// The node the flow starts at
+	NodeID string `json:"nodeId"`
+
+	// The name of the flow
+	Name string `json:"name"`
+}
+
+// PrototypeDevice is the device a prototype is presented in.
+type PrototypeDevice struct {
+	// NONE, PRESET, CUSTOM or PRESENTATION
+	Type string `json:"type"`
+
+	// The size of CUSTOM devices
+	Size *Vector `json:"size,omitempty"`
+
+	// The identifier of PRESET devices
+	PresetIdentifier string `json:"presetIdentifier,omitempty"`
+
+	// NONE or CCW_90
+	Rotation string `json:"rotation"`
+}
+
+// PrototypeFlow is the graph of the prototype of a file: screens are the
+// vertices and interactions navigating between them the edges.
+type PrototypeFlow struct {
+	// The top level frames of the pages, including frames within sections
+	Screens []*Node
+
+	// The interactions navigating from one screen to another
+	Interactions []Interaction
+
+	// The starting points of the flows of each page
+	StartingPoints []FlowStartingPoint
+}
+
+// Interaction is an edge of a prototype flow.
+type Interaction struct {
+	// The screens the interaction navigates from and to
+	From, To string
+
+	// The node of the From screen the reaction belongs to
+	SourceID string
+
+	// The node the action navigates to, either the To screen or a node within
+	// it
+	DestinationID string
+
+	Trigger Trigger
+	Action  Action
+}
+
+// Label returns a description of the interaction, such as
+// "ON_CLICK NAVIGATE SMART_ANIMATE".
+func (i Interaction) Label() string {
+	label := string(i.Trigger.Type)
+	if i.Action.Navigation != "" {
+		label += " " + string(i.Action.Navigation)
+	}
+	if i.Action.Transition != nil {
+		label += " " + string(i.Action.Transition.Type)
+	}
+	return label
+}
+
+// PrototypeFlow extracts the prototype flow graph of the file.
+func (f *File) PrototypeFlow() PrototypeFlow {
+	var flow PrototypeFlow
+
+	screenOf := make(map[string]string)
+	for i := range f.Document.Children {
+		page := &f.Document.Children[i]
+		flow.StartingPoints = append(flow.StartingPoints, page.FlowStartingPoints...)
+		flow.Screens = append(flow.Screens, screens(page)...)
+	}
+	for _, s := range flow.Screens {
+		walk(s, func(n *Node) {
+			screenOf[n.ID] = s.ID
+		})
+	}
+
+	for _, s := range flow.Screens {
+		walk(s, func(n *Node) {
+			for _, a := range nodeActions(n) {
+				if a.action.Type != ActionTypeNode || a.action.DestinationID == "" {
+					continue
+				}
+
+				to, ok := screenOf[a.action.DestinationID]
+				if !ok {
+					to = a.action.DestinationID
+				}
+				flow.Interactions = append(flow.Interactions, Interaction{
+					From:          s.ID,
+					To:            to,
+					SourceID:      n.ID,
+					DestinationID: a.action.DestinationID,
+					Trigger:       a.trigger,
+					Action:        a.action,
+				})
+			}
+		})
+	}
+
+	return flow
+}
+
+type triggeredAction struct {
+	trigger Trigger
+	action  Action
+}
+
+// nodeActions returns the actions of the reactions of n, including the actions
+// nested in CONDITIONAL actions, or the deprecated transition of n if it has no
+// reactions.
+func nodeActions(n *Node) []triggeredAction {
+	var res []triggeredAction
+	for _, r := range n.Reactions {
+		var t Trigger
+		if r.Trigger != nil {
+			t = *r.Trigger
+		}
+		for _, a := range r.AllActions() {
+			for _, a := range a.AllActions() {
+				res = append(res, triggeredAction{t, a})
+			}
+		}
+	}
+
+	if len(n.Reactions) == 0 && n.TransitionNodeID != "" {
+		res = append(res, triggeredAction{
+			Trigger{Type: TriggerTypeOnClick},
+			Action{Type: ActionTypeNode, DestinationID: n.TransitionNodeID, Navigation: NavigationNavigate},
+		})
+	}
+	return res
+}
+
+// screens returns the frames of a page which can be navigated to, including
+// the frames within sections.
+func screens(parent *Node) []*Node {
+	var res []*Node
+	for i := range parent.Children {
+		n := &parent.Children[i]
+		switch n.Type {
+		case NodeTypeSection:
+			res = append(res, screens(n)...)
+		case NodeTypeFrame, NodeTypeComponent, NodeTypeComponentSet, NodeTypeInstance:
+			res = append(res, n)
+		}
+	}
+	return res
+}
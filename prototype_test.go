@@ -0,0 +1,66 @@
+package figma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const conditionalFixture = `{
+	"document": {"id": "0:0", "type": "DOCUMENT", "children": [{
+		"id": "0:1", "type": "CANVAS", "children": [
+			{"id": "1:1", "type": "FRAME", "children": [{
+				"id": "1:2", "type": "INSTANCE",
+				"reactions": [{
+					"trigger": {"type": "ON_CLICK"},
+					"actions": [{
+						"type": "CONDITIONAL",
+						"conditionalBlocks": [
+							{
+								"condition": {"resolvedType": "BOOLEAN", "dataType": "VARIABLE_ALIAS", "value": {"type": "VARIABLE_ALIAS", "id": "VariableID:1:5"}},
+								"actions": [{"type": "NODE", "destinationId": "2:1", "navigation": "NAVIGATE"}]
+							},
+							{
+								"actions": [{"type": "SET_VARIABLE", "variableId": "VariableID:1:5"}]
+							}
+						]
+					}]
+				}]
+			}]},
+			{"id": "2:1", "type": "FRAME"}
+		]
+	}]}
+}`
+
+func TestConditionalActions(t *testing.T) {
+	var f File
+	if err := json.Unmarshal([]byte(conditionalFixture), &f); err != nil {
+		t.Fatal(err)
+	}
+
+	a := f.Document.Children[0].Children[0].Children[0].Reactions[0].Actions[0]
+	if a.Type != ActionTypeConditional || len(a.ConditionalBlocks) != 2 {
+		t.Fatalf("conditional action not decoded: %+v", a)
+	}
+	if c := a.ConditionalBlocks[0].Condition; c == nil || c.DataType != "VARIABLE_ALIAS" || c.ResolvedType != VariableResolvedTypeBoolean {
+		t.Errorf("condition = %+v", c)
+	}
+	if a.ConditionalBlocks[1].Condition != nil {
+		t.Errorf("else block has a condition")
+	}
+	if n := a.ConditionalBlocks[1].Actions; len(n) != 1 || n[0].VariableID != "VariableID:1:5" {
+		t.Errorf("else actions = %+v", n)
+	}
+
+	all := a.AllActions()
+	if len(all) != 3 || all[1].Type != ActionTypeNode || all[2].Type != ActionTypeSetVariable {
+		t.Errorf("AllActions = %+v", all)
+	}
+
+	flow := f.PrototypeFlow()
+	if len(flow.Interactions) != 1 {
+		t.Fatalf("got %d interactions, want 1", len(flow.Interactions))
+	}
+	if i := flow.Interactions[0]; i.From != "1:1" || i.To != "2:1" || i.SourceID != "1:2" {
+		t.Errorf("interaction = %+v", i)
+	}
+}
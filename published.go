@@ -0,0 +1,146 @@
+package figma
+
+import "time"
+
+type publishedComponentResponse struct {
+	Meta PublishedComponent `json:"meta"`
+}
+
+type publishedComponentSetResponse struct {
+	Meta PublishedComponentSet `json:"meta"`
+}
+
+type publishedStyleResponse struct {
+	Meta PublishedStyle `json:"meta"`
+}
+
+// PublishedComponent is a component published to a team library.
+type PublishedComponent struct {
+	// The unique identifier of the component, as referenced by instances
+	Key string `json:"key"`
+
+	// The file containing the component
+	FileKey string `json:"file_key"`
+
+	// ID of the component node within the file
+	NodeID string `json:"node_id"`
+
+	// URL of a thumbnail image of the component
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The name of the component
+	Name string `json:"name"`
+
+	// The description of the component as entered by the publisher
+	Description string `json:"description"`
+
+	// The times at which the component was created and last updated
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// The user who last updated the component
+	User User `json:"user"`
+
+	// The frame the component resides in, if any
+	ContainingFrame FrameInfo `json:"containing_frame"`
+}
+
+// PublishedComponentSet is a set of component variants published to a team
+// library.
+type PublishedComponentSet struct {
+	// The unique identifier of the component set
+	Key string `json:"key"`
+
+	// The file containing the component set
+	FileKey string `json:"file_key"`
+
+	// ID of the component set node within the file
+	NodeID string `json:"node_id"`
+
+	// URL of a thumbnail image of the component set
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The name of the component set
+	Name string `json:"name"`
+
+	// The description of the component set as entered by the publisher
+	Description string `json:"description"`
+
+	// The times at which the component set was created and last updated
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// The user who last updated the component set
+	User User `json:"user"`
+
+	// The frame the component set resides in, if any
+	ContainingFrame FrameInfo `json:"containing_frame"`
+}
+
+// PublishedStyle is a style published to a team library.
+type PublishedStyle struct {
+	// The unique identifier of the style
+	Key string `json:"key"`
+
+	// The file containing the style
+	FileKey string `json:"file_key"`
+
+	// ID of the style node within the file
+	NodeID string `json:"node_id"`
+
+	// The kind of properties the style applies
+	StyleType StyleType `json:"style_type"`
+
+	// URL of a thumbnail image of the style
+	ThumbnailURL string `json:"thumbnail_url"`
+
+	// The name of the style
+	Name string `json:"name"`
+
+	// The description of the style as entered by the publisher
+	Description string `json:"description"`
+
+	// The times at which the style was created and last updated
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// The user who last updated the style
+	User User `json:"user"`
+
+	// A user specified order number by which the style can be sorted
+	SortPosition string `json:"sort_position"`
+}
+
+// FrameInfo describes the frame a published component resides in.
+type FrameInfo struct {
+	// ID and name of the frame
+	NodeID string `json:"nodeId"`
+	Name   string `json:"name"`
+
+	// Background color of the frame
+	BackgroundColor string `json:"backgroundColor"`
+
+	// ID and name of the page the frame is on
+	PageID   string `json:"pageId"`
+	PageName string `json:"pageName"`
+
+	// The component set the component belongs to, if any
+	ContainingStateGroup struct {
+		NodeID string `json:"nodeId"`
+		Name   string `json:"name"`
+	} `json:"containingStateGroup"`
+}
+
+// uniq returns keys without duplicates or empty keys, keeping their order.
+func uniq(keys []string) []string {
+	seen := make(map[string]bool, len(keys))
+	var res []string
+	for _, k := range keys {
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		res = append(res, k)
+	}
+	return res
+}
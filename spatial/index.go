@@ -0,0 +1,298 @@
+// Package spatial indexes the nodes of a Figma page by their bounds, so the
+// nodes at a point or overlapping an area can be found without scanning the
+// whole page.
+package spatial
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/torie/figma"
+	"github.com/torie/figma/geometry"
+)
+
+// maxEntries is the maximum number of children of a node of the tree.
+const maxEntries = 16
+
+// Entry is a node stored in an index.
+type Entry struct {
+	Node *figma.Node
+
+	// The absolute bounds of the node, clipped to the frames clipping their
+	// content it is in
+	Bounds figma.Rectangle
+
+	// The position of the node in paint order: nodes with a higher Z are drawn
+	// above nodes with a lower one
+	Z int
+}
+
+// Index is an R-tree of the nodes of a page, bulk loaded with the Sort-Tile-
+// Recursive algorithm. An index is immutable and safe for concurrent use.
+type Index struct {
+	entries []Entry
+	root    *rnode
+}
+
+type rnode struct {
+	bounds figma.Rectangle
+	items  []item
+}
+
+// item is a child of a tree node: either an entry or another tree node.
+type item struct {
+	bounds figma.Rectangle
+	entry  int
+	node   *rnode
+}
+
+// New returns an index of the visible descendants of page, a canvas of a file
+// or any other node. Hidden nodes and their children are left out, and so are
+// nodes entirely clipped away by a frame clipping its content.
+func New(page *figma.Node) *Index {
+	var entries []Entry
+
+	var visit func(n *figma.Node, clip *figma.Rectangle)
+	visit = func(n *figma.Node, clip *figma.Rectangle) {
+		for i := range n.Children {
+			c := &n.Children[i]
+			if !c.Visible {
+				continue
+			}
+
+			b := c.AbsoluteBoundingBox
+			if clip != nil {
+				var ok bool
+				if b, ok = geometry.Intersect(b, *clip); !ok {
+					continue
+				}
+			}
+			entries = append(entries, Entry{Node: c, Bounds: b, Z: len(entries)})
+
+			cc := clip
+			if c.ClipsContent {
+				cc = &b
+			}
+			visit(c, cc)
+		}
+	}
+	visit(page, nil)
+
+	return Build(entries)
+}
+
+// Build returns an index of the given entries.
+func Build(entries []Entry) *Index {
+	ix := &Index{entries: entries}
+	if len(entries) == 0 {
+		return ix
+	}
+
+	items := make([]item, len(entries))
+	for i, e := range entries {
+		items[i] = item{bounds: e.Bounds, entry: i}
+	}
+
+	level := pack(items)
+	for len(level) > 1 {
+		items = make([]item, len(level))
+		for i, n := range level {
+			items[i] = item{bounds: n.bounds, node: n}
+		}
+		level = pack(items)
+	}
+	ix.root = level[0]
+
+	return ix
+}
+
+// pack groups items into tree nodes of at most maxEntries items: the items are
+// sorted into vertical slices by the x coordinate of their center, and each
+// slice is tiled by the y coordinate of their center.
+func pack(items []item) []*rnode {
+	leaves := (len(items) + maxEntries - 1) / maxEntries
+	slices := int(math.Ceil(math.Sqrt(float64(leaves))))
+	perSlice := slices * maxEntries
+
+	sort.Slice(items, func(i, j int) bool {
+		return centerX(items[i].bounds) < centerX(items[j].bounds)
+	})
+
+	var nodes []*rnode
+	for s := 0; s < len(items); s += perSlice {
+		slice := items[s:min(s+perSlice, len(items))]
+		sort.Slice(slice, func(i, j int) bool {
+			return centerY(slice[i].bounds) < centerY(slice[j].bounds)
+		})
+
+		for t := 0; t < len(slice); t += maxEntries {
+			n := &rnode{items: slice[t:min(t+maxEntries, len(slice)):min(t+maxEntries, len(slice))]}
+			n.bounds = n.items[0].bounds
+			for _, it := range n.items[1:] {
+				n.bounds = geometry.Union(n.bounds, it.bounds)
+			}
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+// Len returns the number of entries in the index.
+func (ix *Index) Len() int {
+	return len(ix.entries)
+}
+
+// Point returns the entries whose bounds contain the point p, topmost first.
+func (ix *Index) Point(p figma.Vector) []Entry {
+	return ix.search(func(r figma.Rectangle) bool {
+		return geometry.ContainsPoint(r, p)
+	}, nil)
+}
+
+// Top returns the topmost entry whose bounds contain the point p, such as the
+// node a comment pinned at p was placed on. It reports false if there is none.
+func (ix *Index) Top(p figma.Vector) (Entry, bool) {
+	res := ix.Point(p)
+	if len(res) == 0 {
+		return Entry{}, false
+	}
+	return res[0], true
+}
+
+// Intersecting returns the entries whose bounds overlap r, topmost first.
+func (ix *Index) Intersecting(r figma.Rectangle) []Entry {
+	return ix.search(func(b figma.Rectangle) bool {
+		return geometry.Intersects(b, r)
+	}, nil)
+}
+
+// Within returns the entries whose bounds lie entirely within r, topmost
+// first.
+func (ix *Index) Within(r figma.Rectangle) []Entry {
+	return ix.search(func(b figma.Rectangle) bool {
+		return geometry.Intersects(b, r)
+	}, func(b figma.Rectangle) bool {
+		return geometry.Contains(r, b)
+	})
+}
+
+// search returns the entries within tree nodes matching visit, whose bounds
+// also match keep if it is not nil.
+func (ix *Index) search(visit, keep func(figma.Rectangle) bool) []Entry {
+	if ix.root == nil {
+		return nil
+	}
+
+	var res []Entry
+	stack := []*rnode{ix.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, it := range n.items {
+			if !visit(it.bounds) {
+				continue
+			}
+			switch {
+			case it.node != nil:
+				stack = append(stack, it.node)
+			case keep == nil || keep(it.bounds):
+				res = append(res, ix.entries[it.entry])
+			}
+		}
+	}
+
+	sortTopmost(res)
+	return res
+}
+
+// Nearest returns the k entries closest to the point p, nearest first. Entries
+// containing p are at distance zero, and entries at the same distance are
+// ordered topmost first.
+func (ix *Index) Nearest(p figma.Vector, k int) []Entry {
+	if ix.root == nil || k <= 0 {
+		return nil
+	}
+
+	var res []Entry
+	q := &queue{{dist: 0, node: ix.root}}
+	for q.Len() > 0 && len(res) < k {
+		c := heap.Pop(q).(candidate)
+		if c.node == nil {
+			res = append(res, ix.entries[c.entry])
+			continue
+		}
+		for _, it := range c.node.items {
+			cand := candidate{dist: distance(it.bounds, p), node: it.node, entry: it.entry}
+			if it.node == nil {
+				cand.z = ix.entries[it.entry].Z
+			}
+			heap.Push(q, cand)
+		}
+	}
+
+	return res
+}
+
+// candidate is a tree node or entry waiting in the queue of a nearest
+// neighbor search.
+type candidate struct {
+	dist  float64
+	node  *rnode
+	entry int
+	z     int
+}
+
+// queue is a priority queue of candidates, closest first. Tree nodes come
+// before entries at the same distance so no closer entry is missed, and
+// entries at the same distance are ordered topmost first.
+type queue []candidate
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	if (q[i].node == nil) != (q[j].node == nil) {
+		return q[i].node != nil
+	}
+	return q[i].z > q[j].z
+}
+
+func (q queue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(candidate)) }
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
+
+// distance returns the distance from the point p to the rectangle r, zero if
+// r contains p.
+func distance(r figma.Rectangle, p figma.Vector) float64 {
+	dx := math.Max(0, math.Max(r.X-p.X, p.X-(r.X+r.Width)))
+	dy := math.Max(0, math.Max(r.Y-p.Y, p.Y-(r.Y+r.Height)))
+	return math.Hypot(dx, dy)
+}
+
+func sortTopmost(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Z > entries[j].Z
+	})
+}
+
+func centerX(r figma.Rectangle) float64 { return r.X + r.Width/2 }
+func centerY(r figma.Rectangle) float64 { return r.Y + r.Height/2 }
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
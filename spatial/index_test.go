@@ -0,0 +1,190 @@
+package spatial
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/torie/figma"
+	"github.com/torie/figma/geometry"
+)
+
+// generatePage returns a page of nested frames, groups and shapes, some of
+// them hidden, some frames clipping their content, and children extending
+// outside of their parents.
+func generatePage(rng *rand.Rand) *figma.Node {
+	page := &figma.Node{ID: "0:1", Type: figma.NodeTypeCanvas, Visible: true}
+	id := 0
+
+	var fill func(parent *figma.Node, area figma.Rectangle, depth int)
+	fill = func(parent *figma.Node, area figma.Rectangle, depth int) {
+		n := 2 + rng.Intn(8)
+		for i := 0; i < n; i++ {
+			id++
+			w, h := rng.Float64()*area.Width/2, rng.Float64()*area.Height/2
+			c := figma.Node{
+				ID:      fmt.Sprintf("1:%d", id),
+				Type:    figma.NodeTypeRectangle,
+				Visible: rng.Intn(10) > 0,
+			}
+			// Children may extend a quarter of the area outside of it.
+			c.AbsoluteBoundingBox = figma.Rectangle{
+				X:      area.X - area.Width/4 + rng.Float64()*area.Width,
+				Y:      area.Y - area.Height/4 + rng.Float64()*area.Height,
+				Width:  w,
+				Height: h,
+			}
+
+			if depth < 3 && rng.Intn(3) == 0 {
+				c.Type = figma.NodeTypeFrame
+				if rng.Intn(2) == 0 {
+					c.Type = figma.NodeTypeGroup
+				}
+				c.ClipsContent = c.Type == figma.NodeTypeFrame && rng.Intn(2) == 0
+				fill(&c, c.AbsoluteBoundingBox, depth+1)
+			}
+			parent.Children = append(parent.Children, c)
+		}
+	}
+	for len(page.Children) < 200 {
+		fill(page, figma.Rectangle{Width: 2000, Height: 2000}, 0)
+	}
+
+	return page
+}
+
+// scan returns the entries of the visible nodes of page in paint order, with
+// their bounds clipped by the frames they are in, without using the index.
+func scan(page *figma.Node) []Entry {
+	var res []Entry
+
+	var visit func(n *figma.Node, clips []figma.Rectangle)
+	visit = func(n *figma.Node, clips []figma.Rectangle) {
+		for i := range n.Children {
+			c := &n.Children[i]
+			if !c.Visible {
+				continue
+			}
+
+			b, ok := c.AbsoluteBoundingBox, true
+			for _, clip := range clips {
+				if b, ok = geometry.Intersect(b, clip); !ok {
+					break
+				}
+			}
+			if !ok {
+				continue
+			}
+			res = append(res, Entry{Node: c, Bounds: b, Z: len(res)})
+
+			cc := clips
+			if c.ClipsContent {
+				cc = append(clips[:len(clips):len(clips)], c.AbsoluteBoundingBox)
+			}
+			visit(c, cc)
+		}
+	}
+	visit(page, nil)
+
+	return res
+}
+
+// filter returns the entries matching keep, topmost first.
+func filter(entries []Entry, keep func(Entry) bool) []Entry {
+	var res []Entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if keep(entries[i]) {
+			res = append(res, entries[i])
+		}
+	}
+	return res
+}
+
+func ids(entries []Entry) []string {
+	res := make([]string, len(entries))
+	for i, e := range entries {
+		res[i] = e.Node.ID
+	}
+	return res
+}
+
+func equal(a, b []Entry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndexMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	page := generatePage(rng)
+
+	all := scan(page)
+	ix := New(page)
+	if ix.Len() != len(all) {
+		t.Fatalf("Len = %d, want %d", ix.Len(), len(all))
+	}
+
+	for q := 0; q < 200; q++ {
+		p := figma.Vector{X: rng.Float64()*2400 - 200, Y: rng.Float64()*2400 - 200}
+		r := figma.Rectangle{X: p.X, Y: p.Y, Width: rng.Float64() * 600, Height: rng.Float64() * 600}
+
+		want := filter(all, func(e Entry) bool { return geometry.ContainsPoint(e.Bounds, p) })
+		if got := ix.Point(p); !equal(got, want) {
+			t.Fatalf("Point(%v) = %v, want %v", p, ids(got), ids(want))
+		}
+
+		want = filter(all, func(e Entry) bool { return geometry.Intersects(e.Bounds, r) })
+		if got := ix.Intersecting(r); !equal(got, want) {
+			t.Fatalf("Intersecting(%v) = %v, want %v", r, ids(got), ids(want))
+		}
+
+		want = filter(all, func(e Entry) bool { return geometry.Contains(r, e.Bounds) })
+		if got := ix.Within(r); !equal(got, want) {
+			t.Fatalf("Within(%v) = %v, want %v", r, ids(got), ids(want))
+		}
+
+		k := 1 + rng.Intn(20)
+		want = filter(all, func(Entry) bool { return true })
+		sort.SliceStable(want, func(i, j int) bool {
+			return distance(want[i].Bounds, p) < distance(want[j].Bounds, p)
+		})
+		want = want[:k]
+		if got := ix.Nearest(p, k); !equal(got, want) {
+			t.Fatalf("Nearest(%v, %d) = %v, want %v", p, k, ids(got), ids(want))
+		}
+	}
+}
+
+func TestIndexClipping(t *testing.T) {
+	page := &figma.Node{ID: "0:1", Type: figma.NodeTypeCanvas, Visible: true, Children: []figma.Node{{
+		ID:           "1:1",
+		Type:         figma.NodeTypeFrame,
+		Visible:      true,
+		LayoutTraits: figma.LayoutTraits{AbsoluteBoundingBox: figma.Rectangle{Width: 100, Height: 100}},
+		FrameTraits:  figma.FrameTraits{ClipsContent: true},
+		Children: []figma.Node{
+			{ID: "1:2", Visible: true, LayoutTraits: figma.LayoutTraits{AbsoluteBoundingBox: figma.Rectangle{X: 50, Y: 50, Width: 100, Height: 100}}},
+			{ID: "1:3", Visible: true, LayoutTraits: figma.LayoutTraits{AbsoluteBoundingBox: figma.Rectangle{X: 200, Y: 200, Width: 10, Height: 10}}},
+			{ID: "1:4", Visible: false, LayoutTraits: figma.LayoutTraits{AbsoluteBoundingBox: figma.Rectangle{Width: 10, Height: 10}}},
+		},
+	}}}
+
+	ix := New(page)
+	if ix.Len() != 2 {
+		t.Fatalf("Len = %d, want the frame and its partly visible child", ix.Len())
+	}
+	if got := ix.Point(figma.Vector{X: 120, Y: 120}); len(got) != 0 {
+		t.Errorf("clipped away part of child found: %v", ids(got))
+	}
+	e, ok := ix.Top(figma.Vector{X: 75, Y: 75})
+	if !ok || e.Node.ID != "1:2" || e.Bounds != (figma.Rectangle{X: 50, Y: 50, Width: 50, Height: 50}) {
+		t.Errorf("Top = %v %+v, want 1:2 clipped to the frame", ok, e)
+	}
+}
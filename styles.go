@@ -0,0 +1,29 @@
+package figma
+
+// NodeStyles returns the styles applied to n, mapped from the kind of property
+// they apply to, e.g. "fill" or "text". Styles missing from the styles table of
+// the file are left out.
+func (f File) NodeStyles(n *Node) map[string]Style {
+	res := make(map[string]Style, len(n.Styles))
+	for kind, id := range n.Styles {
+		if s, ok := f.Styles[id]; ok {
+			res[kind] = s
+		}
+	}
+	return res
+}
+
+// InstanceComponent returns the component the instance n came from.
+func (f File) InstanceComponent(n *Node) (Component, bool) {
+	c, ok := f.Components[n.ComponentID]
+	return c, ok
+}
+
+// ComponentSet returns the component set the component c is a variant of.
+func (f File) ComponentSet(c Component) (ComponentSet, bool) {
+	if c.ComponentSetID == "" {
+		return ComponentSet{}, false
+	}
+	s, ok := f.ComponentSets[c.ComponentSetID]
+	return s, ok
+}
@@ -0,0 +1,147 @@
+package figma
+
+import (
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// TextRun is a segment of text sharing the same style.
+type TextRun struct {
+	// The characters of the run
+	Characters string
+
+	// Byte offsets of the run within the characters of the node
+	Start, End int
+
+	// The style of the run: the style of the node with the overrides of the
+	// run applied
+	Style TypeStyle
+
+	// Paints applied to the characters of the run
+	Fills []Paint
+
+	// Link the characters of the run lead to, if any
+	Hyperlink *Hyperlink
+}
+
+// Runs splits the characters of the text node into runs of consistent style.
+//
+// The character style overrides of a node are indexed by UTF-16 code unit.
+// Runs maps them back to the characters, so a run never splits a character
+// such as an emoji outside the Basic Multilingual Plane.
+func (t *TextNode) Runs() []TextRun {
+	return textRuns(t.TextTraits, t.Fills)
+}
+
+// Runs splits the characters of the text path into runs of consistent style,
+// see TextNode.Runs.
+func (t *TextPathNode) Runs() []TextRun {
+	return textRuns(t.TextTraits, t.Fills)
+}
+
+// Runs splits the characters of the sticky into runs of consistent style, see
+// TextNode.Runs.
+func (s *StickyNode) Runs() []TextRun {
+	return textRuns(s.TextTraits, s.Fills)
+}
+
+// Runs splits the text inside the shape into runs of consistent style, see
+// TextNode.Runs.
+func (s *ShapeWithTextNode) Runs() []TextRun {
+	return textRuns(s.TextTraits, s.Fills)
+}
+
+// Runs splits the label of the connector into runs of consistent style, see
+// TextNode.Runs.
+func (c *ConnectorNode) Runs() []TextRun {
+	return textRuns(c.TextTraits, c.Fills)
+}
+
+// Runs splits the text of the table cell into runs of consistent style, see
+// TextNode.Runs.
+func (c *TableCellNode) Runs() []TextRun {
+	return textRuns(c.TextTraits, c.Fills)
+}
+
+func textRuns(t *TextTraits, fills []Paint) []TextRun {
+	var (
+		runs []TextRun
+		ids  []int
+	)
+
+	unit := 0
+	for i := 0; i < len(t.Characters); {
+		id := 0
+		if unit < len(t.CharacterStyleOverrides) {
+			id = t.CharacterStyleOverrides[unit]
+		}
+
+		r, size := utf8.DecodeRuneInString(t.Characters[i:])
+		unit += utf16Len(r)
+		start, end := i, i+size
+		i = end
+
+		if len(runs) > 0 && ids[len(ids)-1] == id {
+			runs[len(runs)-1].End = end
+			continue
+		}
+		runs = append(runs, TextRun{Start: start, End: end})
+		ids = append(ids, id)
+	}
+
+	for i := range runs {
+		run := &runs[i]
+		run.Characters = t.Characters[run.Start:run.End]
+
+		override, ok := t.StyleOverrideTable[strconv.Itoa(ids[i])]
+		if ids[i] == 0 || !ok {
+			run.Style = t.Style
+		} else {
+			run.Style = t.Style.merge(override)
+		}
+
+		run.Fills = fills
+		if run.Style.IsSet("fills") {
+			run.Fills = run.Style.Fills
+		}
+		run.Hyperlink = run.Style.Hyperlink
+	}
+
+	return runs
+}
+
+// utf16Len returns the number of UTF-16 code units encoding r.
+func utf16Len(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+// merge returns the style with the properties set in o applied to it.
+func (s TypeStyle) merge(o TypeStyle) TypeStyle {
+	res := s
+	res.present = make(fieldSet, len(s.present)+len(o.present))
+	for k := range s.present {
+		res.present[k] = true
+	}
+
+	dst := reflect.ValueOf(&res).Elem()
+	src := reflect.ValueOf(o)
+	for k, idx := range jsonFields(dst.Type()).index {
+		if !o.present[k] {
+			continue
+		}
+		dst.FieldByIndex(idx).Set(src.FieldByIndex(idx))
+		res.present[k] = true
+	}
+
+	return res
+}
+
+// IsSet reports whether the property with the given JSON name, such as
+// "fontSize", was present when the style was decoded.
+func (s TypeStyle) IsSet(name string) bool {
+	return s.present[name]
+}
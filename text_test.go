@@ -0,0 +1,88 @@
+package figma
+
+import "testing"
+
+func TestTextRuns(t *testing.T) {
+	tests := []struct {
+		name  string
+		node  string
+		runs  []string
+		sizes []float64
+	}{
+		{
+			name:  "no overrides",
+			node:  `{"id":"1:1","type":"TEXT","characters":"abc","style":{"fontSize":12}}`,
+			runs:  []string{"abc"},
+			sizes: []float64{12},
+		},
+		{
+			name:  "override after surrogate pair",
+			node:  `{"id":"1:1","type":"TEXT","characters":"a😀b","style":{"fontSize":12},"characterStyleOverrides":[0,0,0,1],"styleOverrideTable":{"1":{"fontSize":20}}}`,
+			runs:  []string{"a😀", "b"},
+			sizes: []float64{12, 20},
+		},
+		{
+			name:  "override of surrogate pair",
+			node:  `{"id":"1:1","type":"TEXT","characters":"a😀b","style":{"fontSize":12},"characterStyleOverrides":[0,1,1],"styleOverrideTable":{"1":{"fontSize":20}}}`,
+			runs:  []string{"a", "😀", "b"},
+			sizes: []float64{12, 20, 12},
+		},
+		{
+			name:  "sticky",
+			node:  `{"id":"1:1","type":"STICKY","characters":"😀ab","style":{"fontSize":12},"characterStyleOverrides":[0,0,0,1],"styleOverrideTable":{"1":{"fontSize":20}}}`,
+			runs:  []string{"😀a", "b"},
+			sizes: []float64{12, 20},
+		},
+		{
+			name:  "table cell",
+			node:  `{"id":"1:1","type":"TABLE_CELL","characters":"a😀b","style":{"fontSize":12},"characterStyleOverrides":[1],"styleOverrideTable":{"1":{"fontSize":20}}}`,
+			runs:  []string{"a", "😀b"},
+			sizes: []float64{20, 12},
+		},
+	}
+
+	for _, tt := range tests {
+		n, err := DecodeNode([]byte(tt.node))
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+
+		var runs []TextRun
+		switch n := n.(type) {
+		case *TextNode:
+			runs = n.Runs()
+		case *StickyNode:
+			runs = n.Runs()
+		case *TableCellNode:
+			runs = n.Runs()
+		}
+
+		if len(runs) != len(tt.runs) {
+			t.Errorf("%s: got %d runs, want %d", tt.name, len(runs), len(tt.runs))
+			continue
+		}
+		for i, r := range runs {
+			if r.Characters != tt.runs[i] || r.Style.FontSize != tt.sizes[i] {
+				t.Errorf("%s: run %d = %q size %v, want %q size %v", tt.name, i, r.Characters, r.Style.FontSize, tt.runs[i], tt.sizes[i])
+			}
+		}
+	}
+}
+
+func TestTextRunsFills(t *testing.T) {
+	n, err := DecodeNode([]byte(`{"id":"1:1","type":"TEXT","characters":"ab","fills":[{"type":"SOLID"}],"characterStyleOverrides":[0,1],"styleOverrideTable":{"1":{"fills":[{"type":"IMAGE"}],"hyperlink":{"type":"URL","url":"https://example.com"}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runs := n.(*TextNode).Runs()
+	if len(runs) != 2 {
+		t.Fatalf("got %d runs, want 2", len(runs))
+	}
+	if runs[0].Fills[0].PaintType != PaintTypeSolid || runs[0].Hyperlink != nil {
+		t.Errorf("first run = %+v", runs[0])
+	}
+	if runs[1].Fills[0].PaintType != PaintTypeImage || runs[1].Hyperlink == nil || runs[1].Hyperlink.URL != "https://example.com" {
+		t.Errorf("second run = %+v", runs[1])
+	}
+}
@@ -0,0 +1,81 @@
+package figma
+
+import "math"
+
+// Multiply returns the transform applying u and then t.
+func (t Transform) Multiply(u Transform) Transform {
+	return Transform{
+		{
+			t[0][0]*u[0][0] + t[0][1]*u[1][0],
+			t[0][0]*u[0][1] + t[0][1]*u[1][1],
+			t[0][0]*u[0][2] + t[0][1]*u[1][2] + t[0][2],
+		},
+		{
+			t[1][0]*u[0][0] + t[1][1]*u[1][0],
+			t[1][0]*u[0][1] + t[1][1]*u[1][1],
+			t[1][0]*u[0][2] + t[1][1]*u[1][2] + t[1][2],
+		},
+	}
+}
+
+// Invert returns the transform undoing t. It reports false if t is not
+// invertible, such as when it scales a dimension to zero.
+func (t Transform) Invert() (Transform, bool) {
+	det := t[0][0]*t[1][1] - t[0][1]*t[1][0]
+	if det == 0 || math.IsNaN(det) {
+		return Transform{}, false
+	}
+
+	a, c := t[1][1]/det, -t[0][1]/det
+	b, d := -t[1][0]/det, t[0][0]/det
+	return Transform{
+		{a, c, -(a*t[0][2] + c*t[1][2])},
+		{b, d, -(b*t[0][2] + d*t[1][2])},
+	}, true
+}
+
+// Apply returns the point p transformed by t.
+func (t Transform) Apply(p Vector) Vector {
+	return Vector{
+		X: t[0][0]*p.X + t[0][1]*p.Y + t[0][2],
+		Y: t[1][0]*p.X + t[1][1]*p.Y + t[1][2],
+	}
+}
+
+// Rotation returns the angle in radians t rotates by.
+func (t Transform) Rotation() float64 {
+	return math.Atan2(t[1][0], t[0][0])
+}
+
+// Bounds returns the axis aligned bounding box of the rectangle of the given
+// size at the origin, once transformed by t. This is how the bounding box of a
+// rotated node relates to its size and transform.
+func (t Transform) Bounds(size Vector) Rectangle {
+	corners := [4]Vector{
+		t.Apply(Vector{}),
+		t.Apply(Vector{X: size.X}),
+		t.Apply(Vector{Y: size.Y}),
+		t.Apply(size),
+	}
+
+	minX, minY := corners[0].X, corners[0].Y
+	maxX, maxY := minX, minY
+	for _, p := range corners[1:] {
+		minX, maxX = math.Min(minX, p.X), math.Max(maxX, p.X)
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Union returns the smallest rectangle containing both r and o.
+func (r Rectangle) Union(o Rectangle) Rectangle {
+	x0, y0 := math.Min(r.X, o.X), math.Min(r.Y, o.Y)
+	x1, y1 := math.Max(r.X+r.Width, o.X+o.Width), math.Max(r.Y+r.Height, o.Y+o.Height)
+	return Rectangle{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// rotated reports whether t rotates or skews, rather than only moving, scaling
+// or flipping. The zero transform of nodes without one is not rotated.
+func (t Transform) rotated() bool {
+	return math.Abs(t[0][1]) > 1e-9 || math.Abs(t[1][0]) > 1e-9
+}
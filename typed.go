@@ -0,0 +1,468 @@
+package figma
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// TypedNode is a node decoded into the concrete type matching its node type,
+// such as *FrameNode or *TextNode. Callers can use a type switch to access the
+// properties of each kind of node:
+//
+//	switch n := n.(type) {
+//	case *TextNode:
+//		fmt.Println(n.Characters)
+//	case *FrameNode:
+//		fmt.Println(n.ClipsContent)
+//	}
+//
+// Typed nodes are views onto a generic Node: the properties of a typed node
+// and of the Node returned by Generic are the same values.
+type TypedNode interface {
+	// ID returns the string uniquely identifying the node within the document.
+	ID() string
+
+	// Name returns the name given to the node by the user in the tool.
+	Name() string
+
+	// Type returns the type of the node.
+	Type() NodeType
+
+	// Children returns the typed children of the node.
+	Children() []TypedNode
+
+	// Generic returns the generic node the typed node is a view of.
+	Generic() *Node
+}
+
+type nodeBase struct {
+	node     *Node
+	children []TypedNode
+}
+
+func newBase(n *Node) nodeBase {
+	b := nodeBase{node: n}
+	for i := range n.Children {
+		b.children = append(b.children, n.Children[i].Typed())
+	}
+	return b
+}
+
+func (b *nodeBase) ID() string            { return b.node.ID }
+func (b *nodeBase) Name() string          { return b.node.Name }
+func (b *nodeBase) Type() NodeType        { return b.node.Type }
+func (b *nodeBase) Children() []TypedNode { return b.children }
+func (b *nodeBase) Generic() *Node        { return b.node }
+
+// DocumentNode is the root node of a file.
+type DocumentNode struct {
+	nodeBase
+}
+
+// CanvasNode is a single page of a file.
+type CanvasNode struct {
+	nodeBase
+	*CanvasTraits
+	*VariableTraits
+	*BackgroundTraits
+	*ExportTraits
+}
+
+// FrameNode is a node of fixed size containing other nodes.
+type FrameNode struct {
+	nodeBase
+	*VariableTraits
+	*BackgroundTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*StyleTraits
+	*CornerTraits
+	*FrameTraits
+	*AutoLayoutTraits
+	*ExportTraits
+	*PrototypeTraits
+	*DevModeTraits
+}
+
+// GroupNode is a logical grouping of nodes.
+type GroupNode struct {
+	FrameNode
+}
+
+// SectionNode is a region of the canvas used to organize frames.
+type SectionNode struct {
+	FrameNode
+	*SectionTraits
+}
+
+// ComponentNode is a node that can have instances created of it that share the
+// same properties.
+type ComponentNode struct {
+	FrameNode
+	*ComponentTraits
+}
+
+// ComponentSetNode is a set of components that are variants of each other.
+type ComponentSetNode struct {
+	FrameNode
+	*ComponentTraits
+}
+
+// InstanceNode is an instance of a component.
+type InstanceNode struct {
+	FrameNode
+	*InstanceTraits
+}
+
+// VectorNode is a vector network, consisting of vertices and edges. The other
+// shape nodes embed it.
+type VectorNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*StyleTraits
+	*CornerTraits
+	*ExportTraits
+	*PrototypeTraits
+	*DevModeTraits
+}
+
+// BooleanOperationNode is a group that has a boolean operation applied to it.
+type BooleanOperationNode struct {
+	VectorNode
+	*BooleanOperationTraits
+}
+
+// StarNode is a regular star shape.
+type StarNode struct {
+	VectorNode
+}
+
+// LineNode is a straight line.
+type LineNode struct {
+	VectorNode
+}
+
+// EllipseNode is an ellipse.
+type EllipseNode struct {
+	VectorNode
+	*EllipseTraits
+}
+
+// RegularPolygonNode is a regular n-sided polygon.
+type RegularPolygonNode struct {
+	VectorNode
+}
+
+// RectangleNode is a rectangle.
+type RectangleNode struct {
+	VectorNode
+}
+
+// TextNode is a text box.
+type TextNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*StyleTraits
+	*ExportTraits
+	*PrototypeTraits
+	*DevModeTraits
+	*TextTraits
+}
+
+// TextPathNode is text laid out along a vector path. Besides the properties
+// of shapes, it has the characters and styles of its text; the path itself is
+// in the fill and stroke geometry.
+type TextPathNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*StyleTraits
+	*ExportTraits
+	*PrototypeTraits
+	*DevModeTraits
+	*TextTraits
+}
+
+// SliceNode is a rectangular region of the canvas that can be exported.
+type SliceNode struct {
+	nodeBase
+	*LayoutTraits
+	*ExportTraits
+}
+
+// TableNode is a table of rows and columns of cells. The API does not return
+// the number of rows and columns: its children are the cells, see Rows.
+type TableNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*ExportTraits
+}
+
+// TableCellNode is a single cell of a table, with the fills and text of the
+// cell.
+type TableCellNode struct {
+	nodeBase
+	*VariableTraits
+	*LayoutTraits
+	*GeometryTraits
+	*StyleTraits
+	*TextTraits
+}
+
+// StickyNode is a FigJam sticky note.
+type StickyNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*ExportTraits
+	*TextTraits
+	*StickyTraits
+}
+
+// ShapeWithTextNode is a FigJam shape with text inside.
+type ShapeWithTextNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*CornerTraits
+	*ExportTraits
+	*TextTraits
+	*ShapeWithTextTraits
+}
+
+// ConnectorNode is a FigJam line connecting two nodes or points.
+type ConnectorNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*ExportTraits
+	*TextTraits
+	*ConnectorTraits
+}
+
+// WashiTapeNode is a FigJam decorative tape. It only has the properties of
+// shapes, its pattern is one of its fills.
+type WashiTapeNode struct {
+	nodeBase
+	*VariableTraits
+	*BlendTraits
+	*LayoutTraits
+	*GeometryTraits
+	*ExportTraits
+}
+
+// EmbedNode is embedded content from another website. The API returns no
+// properties of the embedded content, such as its URL.
+type EmbedNode struct {
+	nodeBase
+	*VariableTraits
+	*LayoutTraits
+	*ExportTraits
+}
+
+// LinkUnfurlNode is a preview of a pasted link. The API returns no properties
+// of the link, such as its URL.
+type LinkUnfurlNode struct {
+	nodeBase
+	*VariableTraits
+	*LayoutTraits
+	*ExportTraits
+}
+
+// UnknownNode is a node of a type this package does not know about. Its
+// properties are available through Generic.
+type UnknownNode struct {
+	nodeBase
+}
+
+// Rows returns the cells of the table by row, top to bottom, and each row
+// left to right. Cells are placed in rows by the top of their bounds.
+func (t *TableNode) Rows() [][]*TableCellNode {
+	var rows [][]*TableCellNode
+	byTop := make(map[float64]int)
+	for _, c := range t.Children() {
+		cell, ok := c.(*TableCellNode)
+		if !ok {
+			continue
+		}
+		top := cell.AbsoluteBoundingBox.Y
+		i, ok := byTop[top]
+		if !ok {
+			i = len(rows)
+			byTop[top] = i
+			rows = append(rows, nil)
+		}
+		rows[i] = append(rows[i], cell)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].AbsoluteBoundingBox.Y < rows[j][0].AbsoluteBoundingBox.Y
+	})
+	for _, r := range rows {
+		r := r
+		sort.Slice(r, func(i, j int) bool {
+			return r[i].AbsoluteBoundingBox.X < r[j].AbsoluteBoundingBox.X
+		})
+	}
+	return rows
+}
+
+// Typed returns the node and its children as typed nodes. Nodes of unknown
+// types are returned as *UnknownNode.
+func (n *Node) Typed() TypedNode {
+	switch n.Type {
+	case NodeTypeDocument:
+		return &DocumentNode{newBase(n)}
+	case NodeTypeCanvas:
+		return &CanvasNode{newBase(n), &n.CanvasTraits, &n.VariableTraits, &n.BackgroundTraits, &n.ExportTraits}
+	case NodeTypeFrame:
+		return newFrameNode(n)
+	case NodeTypeGroup:
+		return &GroupNode{*newFrameNode(n)}
+	case NodeTypeSection:
+		return &SectionNode{*newFrameNode(n), &n.SectionTraits}
+	case NodeTypeComponent:
+		return &ComponentNode{*newFrameNode(n), &n.ComponentTraits}
+	case NodeTypeComponentSet:
+		return &ComponentSetNode{*newFrameNode(n), &n.ComponentTraits}
+	case NodeTypeInstance:
+		return &InstanceNode{*newFrameNode(n), &n.InstanceTraits}
+	case NodeTypeVector:
+		return newVectorNode(n)
+	case NodeTypeBooleanOperation:
+		return &BooleanOperationNode{*newVectorNode(n), &n.BooleanOperationTraits}
+	case NodeTypeStar:
+		return &StarNode{*newVectorNode(n)}
+	case NodeTypeLine:
+		return &LineNode{*newVectorNode(n)}
+	case NodeTypeEllipse:
+		return &EllipseNode{*newVectorNode(n), &n.EllipseTraits}
+	case NodeTypeRegularPolygon:
+		return &RegularPolygonNode{*newVectorNode(n)}
+	case NodeTypeRectangle:
+		return &RectangleNode{*newVectorNode(n)}
+	case NodeTypeText:
+		return &TextNode{
+			nodeBase:        newBase(n),
+			VariableTraits:  &n.VariableTraits,
+			BlendTraits:     &n.BlendTraits,
+			LayoutTraits:    &n.LayoutTraits,
+			GeometryTraits:  &n.GeometryTraits,
+			StyleTraits:     &n.StyleTraits,
+			ExportTraits:    &n.ExportTraits,
+			PrototypeTraits: &n.PrototypeTraits,
+			DevModeTraits:   &n.DevModeTraits,
+			TextTraits:      &n.TextTraits,
+		}
+	case NodeTypeTextPath:
+		return &TextPathNode{
+			nodeBase:        newBase(n),
+			VariableTraits:  &n.VariableTraits,
+			BlendTraits:     &n.BlendTraits,
+			LayoutTraits:    &n.LayoutTraits,
+			GeometryTraits:  &n.GeometryTraits,
+			StyleTraits:     &n.StyleTraits,
+			ExportTraits:    &n.ExportTraits,
+			PrototypeTraits: &n.PrototypeTraits,
+			DevModeTraits:   &n.DevModeTraits,
+			TextTraits:      &n.TextTraits,
+		}
+	case NodeTypeSlice:
+		return &SliceNode{newBase(n), &n.LayoutTraits, &n.ExportTraits}
+	case NodeTypeTable:
+		return &TableNode{newBase(n), &n.VariableTraits, &n.BlendTraits, &n.LayoutTraits, &n.GeometryTraits, &n.ExportTraits}
+	case NodeTypeTableCell:
+		return &TableCellNode{newBase(n), &n.VariableTraits, &n.LayoutTraits, &n.GeometryTraits, &n.StyleTraits, &n.TextTraits}
+	case NodeTypeSticky:
+		return &StickyNode{newBase(n), &n.VariableTraits, &n.BlendTraits, &n.LayoutTraits, &n.GeometryTraits, &n.ExportTraits, &n.TextTraits, &n.StickyTraits}
+	case NodeTypeShapeWithText:
+		return &ShapeWithTextNode{newBase(n), &n.VariableTraits, &n.BlendTraits, &n.LayoutTraits, &n.GeometryTraits, &n.CornerTraits, &n.ExportTraits, &n.TextTraits, &n.ShapeWithTextTraits}
+	case NodeTypeConnector:
+		return &ConnectorNode{newBase(n), &n.VariableTraits, &n.BlendTraits, &n.LayoutTraits, &n.GeometryTraits, &n.ExportTraits, &n.TextTraits, &n.ConnectorTraits}
+	case NodeTypeWashiTape:
+		return &WashiTapeNode{newBase(n), &n.VariableTraits, &n.BlendTraits, &n.LayoutTraits, &n.GeometryTraits, &n.ExportTraits}
+	case NodeTypeEmbed:
+		return &EmbedNode{newBase(n), &n.VariableTraits, &n.LayoutTraits, &n.ExportTraits}
+	case NodeTypeLinkUnfurl:
+		return &LinkUnfurlNode{newBase(n), &n.VariableTraits, &n.LayoutTraits, &n.ExportTraits}
+	default:
+		return &UnknownNode{newBase(n)}
+	}
+}
+
+func newFrameNode(n *Node) *FrameNode {
+	return &FrameNode{
+		nodeBase:         newBase(n),
+		VariableTraits:   &n.VariableTraits,
+		BackgroundTraits: &n.BackgroundTraits,
+		BlendTraits:      &n.BlendTraits,
+		LayoutTraits:     &n.LayoutTraits,
+		GeometryTraits:   &n.GeometryTraits,
+		StyleTraits:      &n.StyleTraits,
+		CornerTraits:     &n.CornerTraits,
+		FrameTraits:      &n.FrameTraits,
+		AutoLayoutTraits: &n.AutoLayoutTraits,
+		ExportTraits:     &n.ExportTraits,
+		PrototypeTraits:  &n.PrototypeTraits,
+		DevModeTraits:    &n.DevModeTraits,
+	}
+}
+
+func newVectorNode(n *Node) *VectorNode {
+	return &VectorNode{
+		nodeBase:        newBase(n),
+		VariableTraits:  &n.VariableTraits,
+		BlendTraits:     &n.BlendTraits,
+		LayoutTraits:    &n.LayoutTraits,
+		GeometryTraits:  &n.GeometryTraits,
+		StyleTraits:     &n.StyleTraits,
+		CornerTraits:    &n.CornerTraits,
+		ExportTraits:    &n.ExportTraits,
+		PrototypeTraits: &n.PrototypeTraits,
+		DevModeTraits:   &n.DevModeTraits,
+	}
+}
+
+// DecodeNode decodes a JSON node, such as one returned in a file's document,
+// into its typed form.
+func DecodeNode(data []byte) (TypedNode, error) {
+	var n Node
+	if err := json.Unmarshal(data, &n); err != nil {
+		return nil, err
+	}
+	return n.Typed(), nil
+}
+
+// TypedDocument returns the document of the file as a typed node tree.
+func (f *File) TypedDocument() *DocumentNode {
+	return &DocumentNode{newBase(&f.Document)}
+}
+
+// Walk calls fn for n and each of its descendants, depth first. The children
+// of a node are skipped if fn returns false for it.
+func Walk(n TypedNode, fn func(TypedNode) bool) {
+	if !fn(n) {
+		return
+	}
+	for _, c := range n.Children() {
+		Walk(c, fn)
+	}
+}
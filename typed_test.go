@@ -0,0 +1,49 @@
+package figma
+
+import "testing"
+
+func TestTypedFigJamAndTableNodes(t *testing.T) {
+	page := `{"id":"0:1","type":"CANVAS","children":[
+		{"id":"1:1","type":"TABLE","absoluteBoundingBox":{"x":0,"y":0,"width":200,"height":40},"strokes":[{"type":"SOLID"}],"children":[
+			{"id":"1:3","type":"TABLE_CELL","characters":"Ada","absoluteBoundingBox":{"x":100,"y":40,"width":100,"height":40}},
+			{"id":"1:2","type":"TABLE_CELL","characters":"Name","fills":[{"type":"SOLID"}],"absoluteBoundingBox":{"x":0,"y":0,"width":100,"height":40}},
+			{"id":"1:4","type":"TABLE_CELL","characters":"Role","absoluteBoundingBox":{"x":100,"y":0,"width":100,"height":40}}]},
+		{"id":"2:1","type":"TEXT_PATH","characters":"around","style":{"fontSize":14},"fillGeometry":[{"path":"M0 0L10 0","windingRule":"NONZERO"}]},
+		{"id":"3:1","type":"WASHI_TAPE","fills":[{"type":"IMAGE"}],"opacity":0.5},
+		{"id":"4:1","type":"EMBED","exportSettings":[{"suffix":"","format":"PNG","constraint":{"type":"SCALE","value":1}}]},
+		{"id":"5:1","type":"LINK_UNFURL","absoluteBoundingBox":{"x":5,"y":6,"width":7,"height":8}},
+		{"id":"6:1","type":"FUTURE_NODE","someProperty":true}]}`
+
+	n, err := DecodeNode([]byte(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := n.Children()
+
+	table, ok := c[0].(*TableNode)
+	if !ok || len(table.Strokes) != 1 || table.AbsoluteBoundingBox.Width != 200 {
+		t.Fatalf("table = %#v", c[0])
+	}
+	if cell, ok := table.Children()[1].(*TableCellNode); !ok || cell.Characters != "Name" || len(cell.Fills) != 1 {
+		t.Errorf("cell = %#v", table.Children()[1])
+	}
+	rows := table.Rows()
+	if len(rows) != 2 || len(rows[0]) != 2 || rows[0][0].ID() != "1:2" || rows[0][1].ID() != "1:4" || rows[1][0].ID() != "1:3" {
+		t.Errorf("rows = %v", rows)
+	}
+	if p, ok := c[1].(*TextPathNode); !ok || p.Characters != "around" || p.Style.FontSize != 14 || len(p.FillGeometry) != 1 {
+		t.Errorf("text path = %#v", c[1])
+	}
+	if w, ok := c[2].(*WashiTapeNode); !ok || w.Fills[0].PaintType != PaintTypeImage || w.Opacity != 0.5 {
+		t.Errorf("washi tape = %#v", c[2])
+	}
+	if e, ok := c[3].(*EmbedNode); !ok || len(e.ExportSettings) != 1 {
+		t.Errorf("embed = %#v", c[3])
+	}
+	if l, ok := c[4].(*LinkUnfurlNode); !ok || l.AbsoluteBoundingBox.Height != 8 {
+		t.Errorf("link unfurl = %#v", c[4])
+	}
+	if u, ok := c[5].(*UnknownNode); !ok || u.Type() != "FUTURE_NODE" || u.Generic().Extra["someProperty"] == nil {
+		t.Errorf("unknown = %#v", c[5])
+	}
+}
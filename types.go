@@ -1,5 +1,7 @@
 package figma
 
+import "encoding/json"
+
 // StrokeAlign specifies where a stroke is drawn relative to the vector outline.
 type StrokeAlign string
 
@@ -11,8 +13,8 @@ const (
 
 // LayoutConstraint specifies the constraint relative to the containing Frame.
 type LayoutConstraint struct {
-	Horizontal HorizontalLayoutConstraint
-	Vertical   VerticalLayoutConstraint
+	Horizontal HorizontalLayoutConstraint `json:"horizontal"`
+	Vertical   VerticalLayoutConstraint   `json:"vertical"`
 }
 
 // HorizontalLayoutConstraint is the layout constraint type for horizontal
@@ -20,64 +22,118 @@ type LayoutConstraint struct {
 type HorizontalLayoutConstraint string
 
 const (
-	// HorizontalLayoutConstraintTop specifies a node which is laid out relative
-	// to top of the containing frame.
-	HorizontalLayoutConstraintTop HorizontalLayoutConstraint = "TOP"
+	// HorizontalLayoutConstraintLeft specifies a node which is laid out
+	// relative to left of the containing frame.
+	HorizontalLayoutConstraintLeft HorizontalLayoutConstraint = "LEFT"
 
-	// HorizontalLayoutConstraintBottom specifies a node which is laid out
-	// relative to bottom of the containing frame.
-	HorizontalLayoutConstraintBottom = "BOTTOM"
+	// HorizontalLayoutConstraintRight specifies a node which is laid out
+	// relative to right of the containing frame.
+	HorizontalLayoutConstraintRight HorizontalLayoutConstraint = "RIGHT"
 
-	// HorizontalLayoutConstraintCenter specifies a node which is vertically
+	// HorizontalLayoutConstraintCenter specifies a node which is horizontally
 	// centered relative to containing frame.
-	HorizontalLayoutConstraintCenter = "CENTER"
+	HorizontalLayoutConstraintCenter HorizontalLayoutConstraint = "CENTER"
 
-	// HorizontalLayoutConstraintTopBottom specifies a node where the top and
-	// bottom of node are constrained relative to containing frame (node
+	// HorizontalLayoutConstraintLeftRight specifies a node where the left and
+	// right side of the node are constrained relative to containing frame (node
 	// stretches with frame).
-	HorizontalLayoutConstraintTopBottom = "TOP_BOTTOM"
+	HorizontalLayoutConstraintLeftRight HorizontalLayoutConstraint = "LEFT_RIGHT"
 
-	// HorizontalLayoutConstraintScale specifies a node which scales vertically
-	// with containing frame.
-	HorizontalLayoutConstraintScale = "SCALE"
+	// HorizontalLayoutConstraintScale specifies a node which scales
+	// horizontally with containing frame.
+	HorizontalLayoutConstraintScale HorizontalLayoutConstraint = "SCALE"
 )
 
 // VerticalLayoutConstraint is the layout constraint type for vertical layout.
 type VerticalLayoutConstraint string
 
 const (
-	// VerticalLayoutConstraintLeft specifies a node which is laid out relative
-	// to left of the containing frame.
-	VerticalLayoutConstraintLeft VerticalLayoutConstraint = "LEFT"
+	// VerticalLayoutConstraintTop specifies a node which is laid out relative
+	// to top of the containing frame.
+	VerticalLayoutConstraintTop VerticalLayoutConstraint = "TOP"
 
-	// VerticalLayoutConstraintRight specifies a node which is laid out relative
-	// to right of the containing frame.
-	VerticalLayoutConstraintRight = "RIGHT"
+	// VerticalLayoutConstraintBottom specifies a node which is laid out
+	// relative to bottom of the containing frame.
+	VerticalLayoutConstraintBottom VerticalLayoutConstraint = "BOTTOM"
 
-	// VerticalLayoutConstraintCenter specifies a node which is horizontally
+	// VerticalLayoutConstraintCenter specifies a node which is vertically
 	// centered relative to containing frame.
-	VerticalLayoutConstraintCenter = "CENTER"
+	VerticalLayoutConstraintCenter VerticalLayoutConstraint = "CENTER"
 
-	// VerticalLayoutConstraintLeftRight specifies a node where the left and
-	// right side of the node are constrained relative to containing frame (node
+	// VerticalLayoutConstraintTopBottom specifies a node where the top and
+	// bottom of node are constrained relative to containing frame (node
 	// stretches with frame).
-	VerticalLayoutConstraintLeftRight = "LEFT_RIGHT"
+	VerticalLayoutConstraintTopBottom VerticalLayoutConstraint = "TOP_BOTTOM"
 
-	// VerticalLayoutConstraintScale specifies a node which scales horizontally
+	// VerticalLayoutConstraintScale specifies a node which scales vertically
 	// with containing frame.
-	VerticalLayoutConstraintScale = "SCALE"
+	VerticalLayoutConstraintScale VerticalLayoutConstraint = "SCALE"
+)
+
+// These constants were declared with the horizontal and vertical constraints
+// swapped. They now have the type matching their value.
+const (
+	// Deprecated: use VerticalLayoutConstraintTop.
+	HorizontalLayoutConstraintTop = VerticalLayoutConstraintTop
+
+	// Deprecated: use VerticalLayoutConstraintBottom.
+	HorizontalLayoutConstraintBottom = VerticalLayoutConstraintBottom
+
+	// Deprecated: use VerticalLayoutConstraintTopBottom.
+	HorizontalLayoutConstraintTopBottom = VerticalLayoutConstraintTopBottom
+
+	// Deprecated: use HorizontalLayoutConstraintLeft.
+	VerticalLayoutConstraintLeft = HorizontalLayoutConstraintLeft
+
+	// Deprecated: use HorizontalLayoutConstraintRight.
+	VerticalLayoutConstraintRight = HorizontalLayoutConstraintRight
+
+	// Deprecated: use HorizontalLayoutConstraintLeftRight.
+	VerticalLayoutConstraintLeftRight = HorizontalLayoutConstraintLeftRight
 )
 
 // LayoutGrid contains guides to align and place objects within a frame.
 type LayoutGrid struct {
-	Pattern     LayoutGridPattern
-	SectionSize float64
-	Visible     bool
-	Color       Color
-	Alignment   Alignment
-	GutterSize  float64
-	Offset      float64
-	Count       int
+	Pattern     LayoutGridPattern `json:"pattern"`
+	SectionSize float64           `json:"sectionSize"`
+	Visible     bool              `json:"visible"` // default: true
+	Color       Color             `json:"color"`
+	Alignment   Alignment         `json:"alignment"`
+	GutterSize  float64           `json:"gutterSize"`
+	Offset      float64           `json:"offset"`
+	Count       int               `json:"count"`
+
+	// Variables bound to properties of the grid, e.g. "count"
+	BoundVariables map[string]VariableAlias `json:"boundVariables"`
+
+	// Properties of the grid not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (g *LayoutGrid) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, g, &g.present, &g.Extra)
+}
+
+func (g *LayoutGrid) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, g, &g.present, &g.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (g LayoutGrid) MarshalJSON() ([]byte, error) {
+	return marshalObject(g, g.present, g.Extra)
+}
+
+func (g *LayoutGrid) setDefaults() {
+	g.Visible = true
+}
+
+// IsSet reports whether the property with the given JSON name was present when
+// the grid was decoded. Omitted properties hold their default value.
+func (g LayoutGrid) IsSet(name string) bool {
+	return g.present[name]
 }
 
 // Alignment describes positioning of a grid.
@@ -101,23 +157,55 @@ const (
 // Effect describes a visual effect such as a shadow or blur.
 type Effect struct {
 	// Type of effect
-	Type EffectType
+	Type EffectType `json:"type"`
 
-	// Is the effect active?
-	Visible bool
+	// Is the effect active? (default: true)
+	Visible bool `json:"visible"`
 
 	// Radius of the blur effect (applies to shadows as well)
-	Radius float64
+	Radius float64 `json:"radius"`
 
 	// The following properties are for shadows only:
 	// The color of the shadow
-	Color Color
+	Color Color `json:"color"`
 
 	// Blend mode of the shadow
-	BlendMode BlendMode
+	BlendMode BlendMode `json:"blendMode"`
 
 	// How far the shadow is projected in the x and y directions
-	Offset Vector
+	Offset Vector `json:"offset"`
+
+	// Variables bound to properties of the effect, e.g. "color" or "radius"
+	BoundVariables map[string]VariableAlias `json:"boundVariables"`
+
+	// Properties of the effect not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (e *Effect) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, e, &e.present, &e.Extra)
+}
+
+func (e *Effect) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, e, &e.present, &e.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (e Effect) MarshalJSON() ([]byte, error) {
+	return marshalObject(e, e.present, e.Extra)
+}
+
+func (e *Effect) setDefaults() {
+	e.Visible = true
+}
+
+// IsSet reports whether the property with the given JSON name was present when
+// the effect was decoded. Omitted properties hold their default value.
+func (e Effect) IsSet(name string) bool {
+	return e.present[name]
 }
 
 // EffectType is the type of effect as a string enum.
@@ -139,10 +227,13 @@ type Vector struct {
 // ColorStop is a position color pair representing a gradient stop.
 type ColorStop struct {
 	// Value between 0 and 1 representing position along gradient axis
-	Position float64
+	Position float64 `json:"position"`
 
 	// Color attached to corresponding position
-	Color Color
+	Color Color `json:"color"`
+
+	// Variables bound to properties of the stop, e.g. "color"
+	BoundVariables map[string]VariableAlias `json:"boundVariables,omitempty"`
 }
 
 // BlendMode describes how a layer blends with layers below.
@@ -150,37 +241,46 @@ type BlendMode string
 
 const (
 	// Normal blends
-	BlendModePassThrough = "PASS_THROUGH" // Only applicable to objects with children
-	BlendModeNormal      = "NORMAL"
+	BlendModePassThrough BlendMode = "PASS_THROUGH" // Only applicable to objects with children
+	BlendModeNormal      BlendMode = "NORMAL"
 
 	// Darken
-	BlendModeDarken     = "DARKEN"
-	BlendModeMultiply   = "MULTIPLY"
-	BlendModeLinearBurn = "LINEAR_BURN"
-	BlendModeColorBurn  = "COLOR_BURN"
+	BlendModeDarken     BlendMode = "DARKEN"
+	BlendModeMultiply   BlendMode = "MULTIPLY"
+	BlendModeLinearBurn BlendMode = "LINEAR_BURN"
+	BlendModeColorBurn  BlendMode = "COLOR_BURN"
 
 	// Lighten
-	BlendModeLighten     = "LIGHTEN"
-	BlendModeScreen      = "SCREEN"
-	BlendModeLinearDodge = "LINEAR_DODGE"
-	BlendModeColorDodge  = "COLOR_DODGE"
+	BlendModeLighten     BlendMode = "LIGHTEN"
+	BlendModeScreen      BlendMode = "SCREEN"
+	BlendModeLinearDodge BlendMode = "LINEAR_DODGE"
+	BlendModeColorDodge  BlendMode = "COLOR_DODGE"
 
 	// Contrast
-	BlendModeOverlay   = "OVERLAY"
-	BlendModeSoftLight = "SOFT_LIGHT"
-	BlendModeHardLight = "HARD_LIGHT"
+	BlendModeOverlay   BlendMode = "OVERLAY"
+	BlendModeSoftLight BlendMode = "SOFT_LIGHT"
+	BlendModeHardLight BlendMode = "HARD_LIGHT"
 
 	// Inversion
-	BlendModeDifference = "DIFFERENCE"
-	BlendModeExclusion  = "EXCLUSION"
+	BlendModeDifference BlendMode = "DIFFERENCE"
+	BlendModeExclusion  BlendMode = "EXCLUSION"
 
 	// Component
-	BlendModeHue        = "HUE"
-	BlendModeSaturation = "SATURATION"
-	BlendModeColor      = "COLOR"
-	BlendModeLuminosity = "LUMINOSITY"
+	BlendModeHue        BlendMode = "HUE"
+	BlendModeSaturation BlendMode = "SATURATION"
+	BlendModeColor      BlendMode = "COLOR"
+	BlendModeLuminosity BlendMode = "LUMINOSITY"
 )
 
+// Transform is a 2x3 affine transformation matrix. The first two columns hold
+// the rotation, scale and skew, and the last column the translation:
+//
+//	[a c e]
+//	[b d f]
+//
+// maps the point (x, y) to (a*x + c*y + e, b*x + d*y + f).
+type Transform [2][3]float64
+
 // Rectangle expresses a bounding box in absolute coordinates.
 type Rectangle struct {
 	X      float64 `json:"x"`
@@ -191,15 +291,18 @@ type Rectangle struct {
 
 // ExportSetting describes the format and size to export an asset at.
 type ExportSetting struct {
-	Suffix     string
-	Format     ImageFormat
-	Constraint string
+	Suffix string      `json:"suffix"`
+	Format ImageFormat `json:"format"`
+
+	// Constraint that determines the size of the exported asset. The field
+	// used to be a string, which could not hold the object sent by the API.
+	Constraint Constraint `json:"constraint"`
 }
 
 // Constraint describes sizing constraint for exports.
 type Constraint struct {
-	Type  ConstraintType
-	Value float64
+	Type  ConstraintType `json:"type"`
+	Value float64        `json:"value"`
 }
 
 // ConstraintType specifies the type of a constraint.
@@ -219,8 +322,11 @@ type TypeStyle struct {
 	// PostScript font name
 	FontPostScriptName string `json:"fontPostScriptName"`
 
+	// Font style as named by the font, e.g. "Bold Italic"
+	FontStyle string `json:"fontStyle"`
+
 	// Is text italicized?
-	Italic bool
+	Italic bool `json:"italic"`
 
 	// Numeric font weight
 	FontWeight float64 `json:"fontWeight"`
@@ -228,33 +334,203 @@ type TypeStyle struct {
 	// Font size in px
 	FontSize float64 `json:"fontSize"`
 
+	// Space between paragraphs in px
+	ParagraphSpacing float64 `json:"paragraphSpacing"`
+
+	// Indentation of the first line of paragraphs in px
+	ParagraphIndent float64 `json:"paragraphIndent"`
+
+	// Space between list items in px
+	ListSpacing float64 `json:"listSpacing"`
+
+	// Text casing applied to the characters
+	TextCase TextCase `json:"textCase"`
+
+	// Text decoration applied to the characters
+	TextDecoration TextDecoration `json:"textDecoration"`
+
+	// How the dimensions of the text box adjust to its contents
+	TextAutoResize TextAutoResize `json:"textAutoResize"`
+
+	// Whether text overflowing the text box is truncated with an ellipsis
+	TextTruncation TextTruncation `json:"textTruncation"`
+
+	// The maximum number of lines before text is truncated, 0 if unlimited
+	MaxLines int `json:"maxLines"`
+
 	// Horizontal text alignment
-	TextAlignHorizontal HorizontalLayoutConstraint `json:"textAlignHorizontal"`
+	TextAlignHorizontal TextAlignHorizontal `json:"textAlignHorizontal"`
 
 	// Vertical text alignment
-	TextAlignVertical VerticalLayoutConstraint `json:"textAlignVertical"`
+	TextAlignVertical TextAlignVertical `json:"textAlignVertical"`
 
 	// Space between characters in px
 	LetterSpacing float64 `json:"letterSpacing"`
 
 	// Paints applied to characters
-	Fills []Paint
+	Fills []Paint `json:"fills"`
+
+	// Link to a URL or node the characters lead to
+	Hyperlink *Hyperlink `json:"hyperlink"`
+
+	// OpenType features applied to the characters, mapped to 1 when enabled and
+	// 0 when disabled
+	OpentypeFlags map[string]int `json:"opentypeFlags"`
 
 	// Line height in px
 	LineHeightPx float64 `json:"lineHeightPx"`
 
 	// Line height as a percentage of normal line height
 	LineHeightPercent float64 `json:"lineHeightPercent"`
+
+	// Line height as a percentage of the font size
+	LineHeightPercentFontSize float64 `json:"lineHeightPercentFontSize"`
+
+	// The unit the line height was specified in by the user
+	LineHeightUnit LineHeightUnit `json:"lineHeightUnit"`
+
+	// Variables bound to properties of the style, mapped by property name
+	BoundVariables map[string]VariableAlias `json:"boundVariables"`
+
+	// Properties of the style not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (s *TypeStyle) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, s, &s.present, &s.Extra)
+}
+
+func (s *TypeStyle) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, s, &s.present, &s.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (s TypeStyle) MarshalJSON() ([]byte, error) {
+	return marshalObject(s, s.present, s.Extra)
 }
 
 // Component is a description of a master component. Helps you identify which
 // component instances are attached to.
 type Component struct {
+	// The key of the component, used to look up published components
+	Key string `json:"key"`
+
 	// The name of the component
-	Name string
+	Name string `json:"name"`
 
 	// The description of the component as entered in the editor
-	Description string
+	Description string `json:"description"`
+
+	// Whether the component is from a team library rather than this file
+	Remote bool `json:"remote"`
+
+	// The ID of the component set the component is a variant of, if any
+	ComponentSetID string `json:"componentSetId"`
+
+	// Links to documentation of the component
+	DocumentationLinks []DocumentationLink `json:"documentationLinks"`
+
+	// Properties of the component not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (c *Component) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, c, &c.present, &c.Extra)
+}
+
+func (c *Component) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, c, &c.present, &c.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (c Component) MarshalJSON() ([]byte, error) {
+	return marshalObject(c, c.present, c.Extra)
+}
+
+// ComponentSet is a description of a set of component variants.
+type ComponentSet struct {
+	// The key of the component set, used to look up published component sets
+	Key string `json:"key"`
+
+	// The name of the component set
+	Name string `json:"name"`
+
+	// The description of the component set as entered in the editor
+	Description string `json:"description"`
+
+	// Whether the component set is from a team library rather than this file
+	Remote bool `json:"remote"`
+
+	// Links to documentation of the component set
+	DocumentationLinks []DocumentationLink `json:"documentationLinks"`
+
+	// Properties of the component set not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (c *ComponentSet) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, c, &c.present, &c.Extra)
+}
+
+func (c *ComponentSet) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, c, &c.present, &c.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (c ComponentSet) MarshalJSON() ([]byte, error) {
+	return marshalObject(c, c.present, c.Extra)
+}
+
+// DocumentationLink is a link to documentation of a component.
+type DocumentationLink struct {
+	URI string `json:"uri"`
+}
+
+// Style is a description of a set of properties which can be applied to
+// nodes.
+type Style struct {
+	// The key of the style, used to look up published styles
+	Key string `json:"key"`
+
+	// The name of the style
+	Name string `json:"name"`
+
+	// The description of the style as entered in the editor
+	Description string `json:"description"`
+
+	// Whether the style is from a team library rather than this file
+	Remote bool `json:"remote"`
+
+	// The kind of properties the style applies
+	StyleType StyleType `json:"styleType"`
+
+	// Properties of the style not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (s *Style) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, s, &s.present, &s.Extra)
+}
+
+func (s *Style) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, s, &s.present, &s.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (s Style) MarshalJSON() ([]byte, error) {
+	return marshalObject(s, s.present, s.Extra)
 }
 
 // Color is an RGBA color.
@@ -267,6 +543,9 @@ type Color struct {
 
 // User contains a description of a user.
 type User struct {
+	//	Unique identifier of the user
+	ID string `json:"id"`
+
 	//	Name of the user
 	Handle string `json:"handle"`
 
@@ -303,15 +582,15 @@ type Paint struct {
 	PaintType PaintType `json:"type"`
 
 	// Is the paint enabled? (default: true)
-	Visible bool
+	Visible bool `json:"visible"`
 
 	// Overall opacity of paint (colors within the paint can also have opacity
 	// values which would blend with this) (default: 1).
-	Opacity float64
+	Opacity float64 `json:"opacity"`
 
 	// For solid paints:
 	// Solid color of the paint
-	Color Color
+	Color Color `json:"color"`
 
 	// For gradient paints: This field contains three vectors, each of which are
 	// a position in normalized object space (normalized object space is if the
@@ -321,16 +600,70 @@ type Paint struct {
 	// the second position is the end of the gradient (value 1), and the third
 	// handle position determines the width of the gradient (only relevant for
 	// non-linear gradients).
-	GradientHandlePositions []Vector
+	GradientHandlePositions []Vector `json:"gradientHandlePositions"`
 
 	// Positions of key points along the gradient axis with the colors anchored
 	// there. Colors along the gradient are interpolated smoothly between
 	// neighboring gradient stops.
-	GradientStops []ColorStop
+	GradientStops []ColorStop `json:"gradientStops"`
+
+	// How the paint blends with the paints below it
+	BlendMode BlendMode `json:"blendMode"`
 
 	// For image paints:
 	// Image scaling mode
-	ScaleMode ScaleMode
+	ScaleMode ScaleMode `json:"scaleMode"`
+
+	// Reference to the image of the paint, see Client.ImageFills
+	ImageRef string `json:"imageRef"`
+
+	// Reference to the animated GIF of the paint, if the image is one
+	GifRef string `json:"gifRef"`
+
+	// Affine transform applied to the image, only for the STRETCH scale mode
+	ImageTransform Transform `json:"imageTransform"`
+
+	// Amount the image is scaled by, only for the TILE scale mode
+	ScalingFactor float64 `json:"scalingFactor"`
+
+	// Rotation of the image in degrees, a multiple of 90
+	Rotation float64 `json:"rotation"`
+
+	// Adjustments applied to the image
+	Filters ImageFilters `json:"filters"`
+
+	// Variables bound to properties of the paint, e.g. "color"
+	BoundVariables map[string]VariableAlias `json:"boundVariables"`
+
+	// Properties of the paint not modeled by this package.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	present fieldSet
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (p *Paint) UnmarshalJSON(b []byte) error {
+	return unmarshalObject(b, p, &p.present, &p.Extra)
+}
+
+func (p *Paint) decodeJSON(dec *json.Decoder) error {
+	return decodeObject(dec, p, &p.present, &p.Extra)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (p Paint) MarshalJSON() ([]byte, error) {
+	return marshalObject(p, p.present, p.Extra)
+}
+
+func (p *Paint) setDefaults() {
+	p.Visible = true
+	p.Opacity = 1
+}
+
+// IsSet reports whether the property with the given JSON name was present when
+// the paint was decoded. Omitted properties hold their default value.
+func (p Paint) IsSet(name string) bool {
+	return p.present[name]
 }
 
 // ScaleMode specifies the scaling mode of an image.
@@ -338,7 +671,409 @@ type ScaleMode string
 
 const (
 	ScaleModeFill    ScaleMode = "FILL"
-	ScaleModeFit               = "FIT"
-	ScaleModeTile              = "TILE"
-	ScaleModeStretch           = "STRETCH"
+	ScaleModeFit     ScaleMode = "FIT"
+	ScaleModeTile    ScaleMode = "TILE"
+	ScaleModeStretch ScaleMode = "STRETCH"
+	ScaleModeCrop    ScaleMode = "CROP"
+)
+
+// ImageFilters are the adjustments applied to an image paint. Each filter
+// ranges from -1 to 1, 0 leaves the image unchanged.
+type ImageFilters struct {
+	Exposure    float64 `json:"exposure,omitempty"`
+	Contrast    float64 `json:"contrast,omitempty"`
+	Saturation  float64 `json:"saturation,omitempty"`
+	Temperature float64 `json:"temperature,omitempty"`
+	Tint        float64 `json:"tint,omitempty"`
+	Highlights  float64 `json:"highlights,omitempty"`
+	Shadows     float64 `json:"shadows,omitempty"`
+}
+
+// StyleType specifies the kind of properties a style applies.
+type StyleType string
+
+const (
+	StyleTypeFill   StyleType = "FILL"
+	StyleTypeText   StyleType = "TEXT"
+	StyleTypeEffect StyleType = "EFFECT"
+	StyleTypeGrid   StyleType = "GRID"
 )
+
+// EditorType specifies the editor a file was created in.
+type EditorType string
+
+const (
+	EditorTypeFigma  EditorType = "figma"
+	EditorTypeFigJam EditorType = "figjam"
+)
+
+// Role specifies the access a user has to a file.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+// LinkAccess specifies who can open a file through its share link.
+type LinkAccess string
+
+const (
+	LinkAccessView          LinkAccess = "view"
+	LinkAccessEdit          LinkAccess = "edit"
+	LinkAccessOrgView       LinkAccess = "org_view"
+	LinkAccessOrgEdit       LinkAccess = "org_edit"
+	LinkAccessInherit       LinkAccess = "inherit"
+	LinkAccessTeamView      LinkAccess = "team_view"
+	LinkAccessTeamEdit      LinkAccess = "team_edit"
+	LinkAccessWorkspaceView LinkAccess = "workspace_view"
+	LinkAccessWorkspaceEdit LinkAccess = "workspace_edit"
+)
+
+// BooleanOperation specifies how the children of a boolean operation node are
+// combined.
+type BooleanOperation string
+
+const (
+	BooleanOperationUnion     BooleanOperation = "UNION"
+	BooleanOperationIntersect BooleanOperation = "INTERSECT"
+	BooleanOperationSubtract  BooleanOperation = "SUBTRACT"
+	BooleanOperationExclude   BooleanOperation = "EXCLUDE"
+)
+
+// StrokeCap specifies the decoration applied to the ends of open paths and
+// connectors.
+type StrokeCap string
+
+const (
+	StrokeCapNone           StrokeCap = "NONE"
+	StrokeCapRound          StrokeCap = "ROUND"
+	StrokeCapSquare         StrokeCap = "SQUARE"
+	StrokeCapLineArrow      StrokeCap = "LINE_ARROW"
+	StrokeCapTriangleArrow  StrokeCap = "TRIANGLE_ARROW"
+	StrokeCapDiamondFilled  StrokeCap = "DIAMOND_FILLED"
+	StrokeCapCircleFilled   StrokeCap = "CIRCLE_FILLED"
+	StrokeCapTriangleFilled StrokeCap = "TRIANGLE_FILLED"
+	StrokeCapWashiTape1     StrokeCap = "WASHI_TAPE_1"
+	StrokeCapWashiTape2     StrokeCap = "WASHI_TAPE_2"
+	StrokeCapWashiTape3     StrokeCap = "WASHI_TAPE_3"
+	StrokeCapWashiTape4     StrokeCap = "WASHI_TAPE_4"
+	StrokeCapWashiTape5     StrokeCap = "WASHI_TAPE_5"
+	StrokeCapWashiTape6     StrokeCap = "WASHI_TAPE_6"
+)
+
+// ShapeType specifies the shape of a FigJam shape with text.
+type ShapeType string
+
+const (
+	ShapeTypeSquare             ShapeType = "SQUARE"
+	ShapeTypeEllipse            ShapeType = "ELLIPSE"
+	ShapeTypeRoundedRectangle   ShapeType = "ROUNDED_RECTANGLE"
+	ShapeTypeDiamond            ShapeType = "DIAMOND"
+	ShapeTypeTriangleUp         ShapeType = "TRIANGLE_UP"
+	ShapeTypeTriangleDown       ShapeType = "TRIANGLE_DOWN"
+	ShapeTypeParallelogramRight ShapeType = "PARALLELOGRAM_RIGHT"
+	ShapeTypeParallelogramLeft  ShapeType = "PARALLELOGRAM_LEFT"
+	ShapeTypeEngDatabase        ShapeType = "ENG_DATABASE"
+	ShapeTypeEngQueue           ShapeType = "ENG_QUEUE"
+	ShapeTypeEngFile            ShapeType = "ENG_FILE"
+	ShapeTypeEngFolder          ShapeType = "ENG_FOLDER"
+	ShapeTypeTrapezoid          ShapeType = "TRAPEZOID"
+	ShapeTypePredefinedProcess  ShapeType = "PREDEFINED_PROCESS"
+	ShapeTypeShield             ShapeType = "SHIELD"
+	ShapeTypeDocumentSingle     ShapeType = "DOCUMENT_SINGLE"
+	ShapeTypeDocumentMultiple   ShapeType = "DOCUMENT_MULTIPLE"
+	ShapeTypeManualInput        ShapeType = "MANUAL_INPUT"
+	ShapeTypeHexagon            ShapeType = "HEXAGON"
+	ShapeTypeChevron            ShapeType = "CHEVRON"
+	ShapeTypePentagon           ShapeType = "PENTAGON"
+	ShapeTypeOctagon            ShapeType = "OCTAGON"
+	ShapeTypeStar               ShapeType = "STAR"
+	ShapeTypePlus               ShapeType = "PLUS"
+	ShapeTypeArrowLeft          ShapeType = "ARROW_LEFT"
+	ShapeTypeArrowRight         ShapeType = "ARROW_RIGHT"
+	ShapeTypeSummingJunction    ShapeType = "SUMMING_JUNCTION"
+	ShapeTypeOr                 ShapeType = "OR"
+	ShapeTypeSpeechBubble       ShapeType = "SPEECH_BUBBLE"
+	ShapeTypeInternalStorage    ShapeType = "INTERNAL_STORAGE"
+)
+
+// ConnectorEndpoint is one end of a connector. It is either attached to a node
+// or placed at an absolute position on the canvas.
+type ConnectorEndpoint struct {
+	// The node the endpoint is attached to, if any
+	EndpointNodeID string `json:"endpointNodeId,omitempty"`
+
+	// The absolute canvas position of an unattached endpoint, or the position
+	// relative to the node of an endpoint attached with a NONE magnet
+	Position *Vector `json:"position,omitempty"`
+
+	// The side of the node the endpoint snaps to
+	Magnet ConnectorMagnet `json:"magnet,omitempty"`
+}
+
+// ConnectorMagnet specifies where a connector attaches to a node.
+type ConnectorMagnet string
+
+const (
+	ConnectorMagnetNone   ConnectorMagnet = "NONE"
+	ConnectorMagnetAuto   ConnectorMagnet = "AUTO"
+	ConnectorMagnetTop    ConnectorMagnet = "TOP"
+	ConnectorMagnetBottom ConnectorMagnet = "BOTTOM"
+	ConnectorMagnetLeft   ConnectorMagnet = "LEFT"
+	ConnectorMagnetRight  ConnectorMagnet = "RIGHT"
+	ConnectorMagnetCenter ConnectorMagnet = "CENTER"
+)
+
+// ConnectorLineType specifies how a connector line is drawn.
+type ConnectorLineType string
+
+const (
+	ConnectorLineTypeElbowed  ConnectorLineType = "ELBOWED"
+	ConnectorLineTypeStraight ConnectorLineType = "STRAIGHT"
+	ConnectorLineTypeCurved   ConnectorLineType = "CURVED"
+)
+
+// ConnectorTextBackground is the background drawn behind a connector label.
+type ConnectorTextBackground struct {
+	CornerRadius float64 `json:"cornerRadius"`
+	Fills        []Paint `json:"fills"`
+}
+
+// TextAlignHorizontal specifies the horizontal alignment of text.
+type TextAlignHorizontal string
+
+const (
+	TextAlignHorizontalLeft      TextAlignHorizontal = "LEFT"
+	TextAlignHorizontalRight     TextAlignHorizontal = "RIGHT"
+	TextAlignHorizontalCenter    TextAlignHorizontal = "CENTER"
+	TextAlignHorizontalJustified TextAlignHorizontal = "JUSTIFIED"
+)
+
+// TextAlignVertical specifies the vertical alignment of text.
+type TextAlignVertical string
+
+const (
+	TextAlignVerticalTop    TextAlignVertical = "TOP"
+	TextAlignVerticalCenter TextAlignVertical = "CENTER"
+	TextAlignVerticalBottom TextAlignVertical = "BOTTOM"
+)
+
+// TextCase specifies the casing applied to text.
+type TextCase string
+
+const (
+	TextCaseOriginal        TextCase = "ORIGINAL"
+	TextCaseUpper           TextCase = "UPPER"
+	TextCaseLower           TextCase = "LOWER"
+	TextCaseTitle           TextCase = "TITLE"
+	TextCaseSmallCaps       TextCase = "SMALL_CAPS"
+	TextCaseSmallCapsForced TextCase = "SMALL_CAPS_FORCED"
+)
+
+// TextDecoration specifies the decoration applied to text.
+type TextDecoration string
+
+const (
+	TextDecorationNone          TextDecoration = "NONE"
+	TextDecorationStrikethrough TextDecoration = "STRIKETHROUGH"
+	TextDecorationUnderline     TextDecoration = "UNDERLINE"
+)
+
+// TextAutoResize specifies how a text box resizes to fit its contents.
+type TextAutoResize string
+
+const (
+	TextAutoResizeNone           TextAutoResize = "NONE"
+	TextAutoResizeHeight         TextAutoResize = "HEIGHT"
+	TextAutoResizeWidthAndHeight TextAutoResize = "WIDTH_AND_HEIGHT"
+
+	// Deprecated: use TextTruncationEnding instead.
+	TextAutoResizeTruncate TextAutoResize = "TRUNCATE"
+)
+
+// TextTruncation specifies whether overflowing text is truncated.
+type TextTruncation string
+
+const (
+	TextTruncationDisabled TextTruncation = "DISABLED"
+	TextTruncationEnding   TextTruncation = "ENDING"
+)
+
+// LineHeightUnit specifies the unit a line height was specified in.
+type LineHeightUnit string
+
+const (
+	LineHeightUnitPixels           LineHeightUnit = "PIXELS"
+	LineHeightUnitFontSizePercent  LineHeightUnit = "FONT_SIZE_%"
+	LineHeightUnitIntrinsicPercent LineHeightUnit = "INTRINSIC_%"
+)
+
+// LineType specifies the list style of a line of text.
+type LineType string
+
+const (
+	LineTypeNone      LineType = "NONE"
+	LineTypeOrdered   LineType = "ORDERED"
+	LineTypeUnordered LineType = "UNORDERED"
+)
+
+// HyperlinkType specifies what a hyperlink leads to.
+type HyperlinkType string
+
+const (
+	HyperlinkTypeURL  HyperlinkType = "URL"
+	HyperlinkTypeNode HyperlinkType = "NODE"
+)
+
+// Hyperlink is a link to a URL or to a node in the same file.
+type Hyperlink struct {
+	Type HyperlinkType `json:"type"`
+
+	// The URL the link leads to, for URL links
+	URL string `json:"url,omitempty"`
+
+	// The node the link leads to, for NODE links
+	NodeID string `json:"nodeID,omitempty"`
+}
+
+// VariableAlias is a reference to a variable.
+type VariableAlias struct {
+	// Always VARIABLE_ALIAS
+	Type string `json:"type"`
+
+	// The ID of the variable
+	ID string `json:"id"`
+}
+
+// LayoutMode specifies the direction of an auto layout.
+type LayoutMode string
+
+const (
+	LayoutModeNone       LayoutMode = "NONE"
+	LayoutModeHorizontal LayoutMode = "HORIZONTAL"
+	LayoutModeVertical   LayoutMode = "VERTICAL"
+)
+
+// LayoutWrap specifies whether an auto layout wraps its children.
+type LayoutWrap string
+
+const (
+	LayoutWrapNoWrap LayoutWrap = "NO_WRAP"
+	LayoutWrapWrap   LayoutWrap = "WRAP"
+)
+
+// AxisSizingMode specifies how an auto layout frame is sized on an axis.
+type AxisSizingMode string
+
+const (
+	AxisSizingModeFixed AxisSizingMode = "FIXED"
+	AxisSizingModeAuto  AxisSizingMode = "AUTO"
+)
+
+// PrimaryAxisAlignItems specifies how children are aligned on the primary
+// axis of an auto layout.
+type PrimaryAxisAlignItems string
+
+const (
+	PrimaryAxisAlignItemsMin          PrimaryAxisAlignItems = "MIN"
+	PrimaryAxisAlignItemsCenter       PrimaryAxisAlignItems = "CENTER"
+	PrimaryAxisAlignItemsMax          PrimaryAxisAlignItems = "MAX"
+	PrimaryAxisAlignItemsSpaceBetween PrimaryAxisAlignItems = "SPACE_BETWEEN"
+)
+
+// CounterAxisAlignItems specifies how children are aligned on the counter
+// axis of an auto layout.
+type CounterAxisAlignItems string
+
+const (
+	CounterAxisAlignItemsMin      CounterAxisAlignItems = "MIN"
+	CounterAxisAlignItemsCenter   CounterAxisAlignItems = "CENTER"
+	CounterAxisAlignItemsMax      CounterAxisAlignItems = "MAX"
+	CounterAxisAlignItemsBaseline CounterAxisAlignItems = "BASELINE"
+)
+
+// CounterAxisAlignContent specifies how wrapped lines of an auto layout are
+// distributed.
+type CounterAxisAlignContent string
+
+const (
+	CounterAxisAlignContentAuto         CounterAxisAlignContent = "AUTO"
+	CounterAxisAlignContentSpaceBetween CounterAxisAlignContent = "SPACE_BETWEEN"
+)
+
+// LayoutAlign specifies how a child is aligned on the counter axis of an auto
+// layout.
+type LayoutAlign string
+
+const (
+	LayoutAlignInherit LayoutAlign = "INHERIT"
+	LayoutAlignStretch LayoutAlign = "STRETCH"
+	LayoutAlignMin     LayoutAlign = "MIN"
+	LayoutAlignCenter  LayoutAlign = "CENTER"
+	LayoutAlignMax     LayoutAlign = "MAX"
+)
+
+// LayoutPositioning specifies whether a child takes part in an auto layout.
+type LayoutPositioning string
+
+const (
+	LayoutPositioningAuto     LayoutPositioning = "AUTO"
+	LayoutPositioningAbsolute LayoutPositioning = "ABSOLUTE"
+)
+
+// LayoutSizing specifies how a child of an auto layout is sized on an axis.
+type LayoutSizing string
+
+const (
+	LayoutSizingFixed LayoutSizing = "FIXED"
+	LayoutSizingHug   LayoutSizing = "HUG"
+	LayoutSizingFill  LayoutSizing = "FILL"
+)
+
+// StrokeJoin specifies how the corners of a stroke are joined.
+type StrokeJoin string
+
+const (
+	StrokeJoinMiter StrokeJoin = "MITER"
+	StrokeJoinBevel StrokeJoin = "BEVEL"
+	StrokeJoinRound StrokeJoin = "ROUND"
+)
+
+// StrokeWeights are the weights of each side of a stroke.
+type StrokeWeights struct {
+	Top    float64 `json:"top"`
+	Right  float64 `json:"right"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
+}
+
+// WindingRule specifies how the inside of a path is determined.
+type WindingRule string
+
+const (
+	WindingRuleNonZero WindingRule = "NONZERO"
+	WindingRuleEvenOdd WindingRule = "EVENODD"
+)
+
+// Path is a vector outline in SVG path notation.
+type Path struct {
+	// The outline, e.g. "M 0 0 L 10 0 L 10 10 Z"
+	Path string `json:"path"`
+
+	// How the inside of the path is determined
+	WindingRule WindingRule `json:"windingRule"`
+
+	// The style override table entry applying to the path, if any
+	OverrideID int `json:"overrideID,omitempty"`
+}
+
+// ArcData describes the arc of a partial ellipse. Angles are in radians.
+type ArcData struct {
+	StartingAngle float64 `json:"startingAngle"`
+	EndingAngle   float64 `json:"endingAngle"`
+
+	// The radius of the hole in the ellipse, as a ratio of its radius
+	InnerRadius float64 `json:"innerRadius"`
+}
@@ -0,0 +1,455 @@
+package figma
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type localVariablesResponse struct {
+	Meta LocalVariables `json:"meta"`
+}
+
+// LocalVariables are the variables and variable collections defined in a
+// file, mapped by ID.
+type LocalVariables struct {
+	Variables           map[string]Variable           `json:"variables"`
+	VariableCollections map[string]VariableCollection `json:"variableCollections"`
+}
+
+// VariableResolvedType specifies the type of the value of a variable.
+type VariableResolvedType string
+
+const (
+	VariableResolvedTypeBoolean VariableResolvedType = "BOOLEAN"
+	VariableResolvedTypeFloat   VariableResolvedType = "FLOAT"
+	VariableResolvedTypeString  VariableResolvedType = "STRING"
+	VariableResolvedTypeColor   VariableResolvedType = "COLOR"
+)
+
+// Variable is a named value with a value for each mode of its collection.
+type Variable struct {
+	// Unique identifier of the variable within the file
+	ID string `json:"id"`
+
+	// The name of the variable
+	Name string `json:"name"`
+
+	// The key of the variable, used to reference published variables
+	Key string `json:"key"`
+
+	// The collection the variable belongs to
+	VariableCollectionID string `json:"variableCollectionId"`
+
+	// The type of the values of the variable
+	ResolvedType VariableResolvedType `json:"resolvedType"`
+
+	// The value of the variable for each mode, mapped by mode ID
+	ValuesByMode map[string]VariableValue `json:"valuesByMode"`
+
+	// Whether the variable is from a team library rather than this file
+	Remote bool `json:"remote"`
+
+	// The description of the variable as entered in the editor
+	Description string `json:"description"`
+
+	// Whether the variable is hidden when publishing the library
+	HiddenFromPublishing bool `json:"hiddenFromPublishing"`
+
+	// The properties the variable is suggested for, e.g. "CORNER_RADIUS"
+	Scopes []string `json:"scopes"`
+
+	// The code syntax of the variable per platform, e.g. "WEB"
+	CodeSyntax map[string]string `json:"codeSyntax"`
+}
+
+// VariableCollection is a group of variables sharing the same modes.
+type VariableCollection struct {
+	// Unique identifier of the collection within the file
+	ID string `json:"id"`
+
+	// The name of the collection
+	Name string `json:"name"`
+
+	// The key of the collection, used to reference published collections
+	Key string `json:"key"`
+
+	// The modes of the collection, e.g. light and dark
+	Modes []VariableMode `json:"modes"`
+
+	// The mode used when no mode is set
+	DefaultModeID string `json:"defaultModeId"`
+
+	// Whether the collection is from a team library rather than this file
+	Remote bool `json:"remote"`
+
+	// Whether the collection is hidden when publishing the library
+	HiddenFromPublishing bool `json:"hiddenFromPublishing"`
+
+	// The variables of the collection
+	VariableIDs []string `json:"variableIds"`
+}
+
+// VariableMode is a mode of a variable collection.
+type VariableMode struct {
+	ModeID string `json:"modeId"`
+	Name   string `json:"name"`
+}
+
+// VariableValue is the value of a variable in a mode: a boolean, number,
+// string or color, or an alias of another variable.
+type VariableValue struct {
+	// The type of the value, empty for aliases
+	Type VariableResolvedType
+
+	Bool   bool
+	Float  float64
+	String string
+	Color  Color
+
+	// The variable the value refers to, if it is an alias
+	Alias *VariableAlias
+}
+
+// Value returns the value as a bool, float64, string or Color, or the alias.
+func (v VariableValue) Value() interface{} {
+	switch {
+	case v.Alias != nil:
+		return *v.Alias
+	case v.Type == VariableResolvedTypeBoolean:
+		return v.Bool
+	case v.Type == VariableResolvedTypeFloat:
+		return v.Float
+	case v.Type == VariableResolvedTypeString:
+		return v.String
+	case v.Type == VariableResolvedTypeColor:
+		return v.Color
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (v *VariableValue) UnmarshalJSON(b []byte) error {
+	*v = VariableValue{}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return errors.New("empty variable value")
+	}
+
+	switch b[0] {
+	case 't', 'f':
+		v.Type = VariableResolvedTypeBoolean
+		return json.Unmarshal(b, &v.Bool)
+	case '"':
+		v.Type = VariableResolvedTypeString
+		return json.Unmarshal(b, &v.String)
+	case '{':
+		var alias VariableAlias
+		if err := json.Unmarshal(b, &alias); err != nil {
+			return err
+		}
+		if alias.Type == "VARIABLE_ALIAS" {
+			v.Alias = &alias
+			return nil
+		}
+		v.Type = VariableResolvedTypeColor
+		return json.Unmarshal(b, &v.Color)
+	case 'n':
+		return nil
+	default:
+		v.Type = VariableResolvedTypeFloat
+		return json.Unmarshal(b, &v.Float)
+	}
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (v VariableValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value())
+}
+
+// VariableBinding is the binding of variables to a property of a node: a
+// single variable, one variable per item of list properties such as fills, or
+// one variable per component property.
+type VariableBinding struct {
+	Alias *VariableAlias
+	List  []VariableAlias
+	Map   map[string]VariableAlias
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (b *VariableBinding) UnmarshalJSON(data []byte) error {
+	*b = VariableBinding{}
+
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] == 'n' {
+		return nil
+	}
+	if data[0] == '[' {
+		return json.Unmarshal(data, &b.List)
+	}
+
+	var alias VariableAlias
+	if err := json.Unmarshal(data, &alias); err == nil && alias.Type == "VARIABLE_ALIAS" {
+		b.Alias = &alias
+		return nil
+	}
+	return json.Unmarshal(data, &b.Map)
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (b VariableBinding) MarshalJSON() ([]byte, error) {
+	switch {
+	case b.Alias != nil:
+		return json.Marshal(b.Alias)
+	case b.List != nil:
+		return json.Marshal(b.List)
+	case b.Map != nil:
+		return json.Marshal(b.Map)
+	}
+	return []byte("null"), nil
+}
+
+// maxAliasDepth bounds the length of alias chains, so cyclic aliases fail
+// instead of recursing forever.
+const maxAliasDepth = 64
+
+// VariableResolver computes the values of variables for chosen modes.
+type VariableResolver struct {
+	vars  LocalVariables
+	modes map[string]string
+}
+
+// NewVariableResolver returns a resolver of the variables vars. The mode used
+// for each collection is the one chosen in modes, mapped from collection ID to
+// mode ID, or the default mode of the collection.
+func NewVariableResolver(vars LocalVariables, modes map[string]string) *VariableResolver {
+	return &VariableResolver{vars: vars, modes: modes}
+}
+
+// WithModes returns a resolver which uses modes in preference to the modes of
+// r, as nodes setting explicit variable modes do.
+func (r *VariableResolver) WithModes(modes map[string]string) *VariableResolver {
+	if len(modes) == 0 {
+		return r
+	}
+
+	merged := make(map[string]string, len(r.modes)+len(modes))
+	for k, v := range r.modes {
+		merged[k] = v
+	}
+	for k, v := range modes {
+		merged[k] = v
+	}
+	return &VariableResolver{vars: r.vars, modes: merged}
+}
+
+// Resolve returns the value of the variable with the given ID, following
+// aliases to other variables.
+func (r *VariableResolver) Resolve(id string) (VariableValue, error) {
+	for depth := 0; depth < maxAliasDepth; depth++ {
+		v, ok := r.vars.Variables[id]
+		if !ok {
+			return VariableValue{}, fmt.Errorf("variable %s not found", id)
+		}
+
+		mode := r.mode(v.VariableCollectionID)
+		val, ok := v.ValuesByMode[mode]
+		if !ok {
+			return VariableValue{}, fmt.Errorf("variable %s has no value for mode %s", v.Name, mode)
+		}
+		if val.Alias == nil {
+			return val, nil
+		}
+		id = val.Alias.ID
+	}
+
+	return VariableValue{}, fmt.Errorf("variable %s: too many aliases", id)
+}
+
+func (r *VariableResolver) mode(collection string) string {
+	if m, ok := r.modes[collection]; ok {
+		return m
+	}
+	return r.vars.VariableCollections[collection].DefaultModeID
+}
+
+// Values returns the values of the variables bound to n and to its paints,
+// effects, grids and text style, mapped by property path, e.g. "itemSpacing",
+// "fills[0].color" or "style.fontSize". The explicit variable modes of n are
+// used in preference to the modes of r, use Tree to also inherit the modes of
+// ancestors.
+//
+// Bindings which cannot be resolved, such as those of library variables not
+// defined in the file, are left out and reported in the returned error.
+func (r *VariableResolver) Values(n *Node) (map[string]VariableValue, error) {
+	return r.WithModes(n.ExplicitVariableModes).values(n)
+}
+
+// listBindings are the properties whose bindings are listed both on the node
+// and on each item of the list. Only the bindings of the items are used, as
+// they name the property of the item which is bound.
+var listBindings = map[string]bool{
+	"fills":       true,
+	"strokes":     true,
+	"effects":     true,
+	"layoutGrids": true,
+}
+
+// values returns the values of the variables bound to n, using the modes of r
+// as is.
+func (r *VariableResolver) values(n *Node) (map[string]VariableValue, error) {
+	bindings := make(map[string]VariableAlias)
+	for name, b := range n.BoundVariables {
+		if listBindings[name] {
+			continue
+		}
+		switch {
+		case b.Alias != nil:
+			bindings[name] = *b.Alias
+		case b.List != nil:
+			for i, a := range b.List {
+				bindings[fmt.Sprintf("%s[%d]", name, i)] = a
+			}
+		default:
+			for k, a := range b.Map {
+				bindings[name+"."+k] = a
+			}
+		}
+	}
+	addBindings(bindings, "fills", n.Fills)
+	addBindings(bindings, "strokes", n.Strokes)
+	for i, e := range n.Effects {
+		for k, a := range e.BoundVariables {
+			bindings[fmt.Sprintf("effects[%d].%s", i, k)] = a
+		}
+	}
+	for i, g := range n.LayoutGrids {
+		for k, a := range g.BoundVariables {
+			bindings[fmt.Sprintf("layoutGrids[%d].%s", i, k)] = a
+		}
+	}
+	for k, a := range n.Style.BoundVariables {
+		bindings["style."+k] = a
+	}
+
+	res := make(map[string]VariableValue, len(bindings))
+	var failed []string
+	for path, a := range bindings {
+		v, err := r.Resolve(a.ID)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", path, err))
+			continue
+		}
+		res[path] = v
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return res, fmt.Errorf("%s: failed to resolve variables: %s", n.ID, strings.Join(failed, ", "))
+	}
+	return res, nil
+}
+
+func addBindings(bindings map[string]VariableAlias, name string, paints []Paint) {
+	for i, p := range paints {
+		for k, a := range p.BoundVariables {
+			bindings[fmt.Sprintf("%s[%d].%s", name, i, k)] = a
+		}
+	}
+}
+
+// Tree returns the values of the variables bound to root and its descendants,
+// mapped by node ID, see Values. Explicit variable modes are inherited from
+// parents to children.
+func (r *VariableResolver) Tree(root *Node) (map[string]map[string]VariableValue, error) {
+	res := make(map[string]map[string]VariableValue)
+	var failed []string
+
+	var visit func(n *Node, r *VariableResolver)
+	visit = func(n *Node, r *VariableResolver) {
+		r = r.WithModes(n.ExplicitVariableModes)
+		vals, err := r.values(n)
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+		if len(vals) > 0 {
+			res[n.ID] = vals
+		}
+		for i := range n.Children {
+			visit(&n.Children[i], r)
+		}
+	}
+	visit(root, r)
+
+	if len(failed) > 0 {
+		return res, errors.New(strings.Join(failed, "; "))
+	}
+	return res, nil
+}
+
+// ApplyVariables sets the properties of n to the values in vals, as returned by Values,
+// so n reflects the chosen modes. Properties of n's children are not changed.
+// Paths which do not match a property of a matching type are ignored.
+func ApplyVariables(n *Node, vals map[string]VariableValue) {
+	for path, v := range vals {
+		applyPath(reflect.ValueOf(n).Elem(), path, v)
+	}
+}
+
+func applyPath(rv reflect.Value, path string, v VariableValue) {
+	name, rest := path, ""
+	if i := strings.IndexAny(path, ".["); i >= 0 {
+		name, rest = path[:i], path[i:]
+	}
+
+	idx, ok := jsonFields(rv.Type()).index[name]
+	if !ok {
+		return
+	}
+	f := rv.FieldByIndex(idx)
+
+	switch {
+	case rest == "":
+		setValue(f, v)
+	case rest[0] == '.' && f.Kind() == reflect.Struct:
+		applyPath(f, rest[1:], v)
+	case rest[0] == '[' && f.Kind() == reflect.Slice:
+		var i int
+		var tail string
+		if _, err := fmt.Sscanf(rest, "[%d]", &i); err != nil || i < 0 || i >= f.Len() {
+			return
+		}
+		tail = rest[strings.Index(rest, "]")+1:]
+		if tail == "" {
+			setValue(f.Index(i), v)
+		} else if tail[0] == '.' && f.Index(i).Kind() == reflect.Struct {
+			applyPath(f.Index(i), tail[1:], v)
+		}
+	}
+}
+
+// setValue sets the property f to v if its type matches. Optional properties,
+// such as maxWidth, are set through their pointer if they are set.
+func setValue(f reflect.Value, v VariableValue) {
+	if f.Kind() == reflect.Ptr && !f.IsNil() {
+		f = f.Elem()
+	}
+
+	switch {
+	case v.Type == VariableResolvedTypeColor && f.Type() == reflect.TypeOf(Color{}):
+		f.Set(reflect.ValueOf(v.Color))
+	case v.Type == VariableResolvedTypeFloat && (f.Kind() == reflect.Float64 || f.Kind() == reflect.Float32):
+		f.SetFloat(v.Float)
+	case v.Type == VariableResolvedTypeFloat && f.Kind() == reflect.Int:
+		f.SetInt(int64(v.Float))
+	case v.Type == VariableResolvedTypeBoolean && f.Kind() == reflect.Bool:
+		f.SetBool(v.Bool)
+	case v.Type == VariableResolvedTypeString && f.Kind() == reflect.String:
+		f.SetString(v.String)
+	}
+}
@@ -0,0 +1,136 @@
+package figma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const variablesFixture = `{
+	"variables": {
+		"V:1": {"id": "V:1", "variableCollectionId": "C:1", "resolvedType": "FLOAT", "valuesByMode": {"m1": 8, "m2": 16}},
+		"V:2": {"id": "V:2", "variableCollectionId": "C:1", "resolvedType": "COLOR", "valuesByMode": {"m1": {"type": "VARIABLE_ALIAS", "id": "V:3"}, "m2": {"r": 1, "g": 0, "b": 0, "a": 1}}},
+		"V:3": {"id": "V:3", "variableCollectionId": "C:1", "resolvedType": "COLOR", "valuesByMode": {"m1": {"r": 0, "g": 1, "b": 0, "a": 1}, "m2": {"r": 0, "g": 0, "b": 1, "a": 1}}}
+	},
+	"variableCollections": {
+		"C:1": {"id": "C:1", "defaultModeId": "m1", "modes": [{"modeId": "m1", "name": "Light"}, {"modeId": "m2", "name": "Dark"}]}
+	}
+}`
+
+const variablesNode = `{
+	"id": "1:1",
+	"type": "FRAME",
+	"itemSpacing": 1,
+	"boundVariables": {
+		"itemSpacing": {"type": "VARIABLE_ALIAS", "id": "V:1"},
+		"fills": [{"type": "VARIABLE_ALIAS", "id": "V:2"}]
+	},
+	"fills": [{"type": "SOLID", "color": {"r": 0, "g": 0, "b": 0, "a": 1}, "boundVariables": {"color": {"type": "VARIABLE_ALIAS", "id": "V:2"}}}],
+	"children": [{
+		"id": "1:2",
+		"type": "FRAME",
+		"explicitVariableModes": {"C:1": "m2"},
+		"children": [{
+			"id": "1:3",
+			"type": "FRAME",
+			"boundVariables": {"itemSpacing": {"type": "VARIABLE_ALIAS", "id": "V:1"}}
+		}]
+	}, {
+		"id": "1:4",
+		"type": "FRAME",
+		"boundVariables": {"itemSpacing": {"type": "VARIABLE_ALIAS", "id": "V:9"}}
+	}]
+}`
+
+func decodeVariables(t *testing.T) (*VariableResolver, *Node) {
+	t.Helper()
+	var vars LocalVariables
+	if err := json.Unmarshal([]byte(variablesFixture), &vars); err != nil {
+		t.Fatal(err)
+	}
+	var n Node
+	if err := json.Unmarshal([]byte(variablesNode), &n); err != nil {
+		t.Fatal(err)
+	}
+	return NewVariableResolver(vars, nil), &n
+}
+
+func TestVariableValues(t *testing.T) {
+	r, n := decodeVariables(t)
+
+	vals, err := r.Values(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vals) != 2 {
+		t.Errorf("got %d values, want itemSpacing and fills[0].color: %v", len(vals), vals)
+	}
+	if v := vals["itemSpacing"]; v.Float != 8 {
+		t.Errorf("itemSpacing = %v, want 8", v.Float)
+	}
+	if v := vals["fills[0].color"]; v.Color.Green != 1 {
+		t.Errorf("fills[0].color = %+v, want the aliased green", v.Color)
+	}
+
+	ApplyVariables(n, vals)
+	if n.ItemSpacing != 8 || n.Fills[0].Color.Green != 1 {
+		t.Errorf("values not applied: itemSpacing %v fill %+v", n.ItemSpacing, n.Fills[0].Color)
+	}
+}
+
+func TestVariableTree(t *testing.T) {
+	r, n := decodeVariables(t)
+
+	tree, err := r.Tree(n)
+	if err == nil {
+		t.Error("unresolved variable not reported")
+	}
+	if v := tree["1:1"]["itemSpacing"]; v.Float != 8 {
+		t.Errorf("1:1 itemSpacing = %v, want 8 in the default mode", v.Float)
+	}
+	if v := tree["1:3"]["itemSpacing"]; v.Float != 16 {
+		t.Errorf("1:3 itemSpacing = %v, want 16 in the mode inherited from 1:2", v.Float)
+	}
+	if _, ok := tree["1:4"]; ok {
+		t.Errorf("1:4 has values for an unresolved variable: %v", tree["1:4"])
+	}
+}
+
+func TestApplyVariablesMaxWidth(t *testing.T) {
+	r, _ := decodeVariables(t)
+
+	var n Node
+	if err := json.Unmarshal([]byte(`{
+		"id": "1:1",
+		"type": "FRAME",
+		"absoluteBoundingBox": {"x": 0, "y": 0, "width": 100, "height": 50},
+		"children": [{
+			"id": "1:2",
+			"type": "FRAME",
+			"absoluteBoundingBox": {"x": 10, "y": 10, "width": 4, "height": 30},
+			"constraints": {"horizontal": "LEFT_RIGHT", "vertical": "TOP"},
+			"maxWidth": 200,
+			"boundVariables": {"maxWidth": {"type": "VARIABLE_ALIAS", "id": "V:1"}}
+		}]
+	}`), &n); err != nil {
+		t.Fatal(err)
+	}
+
+	child := &n.Children[0]
+	vals, err := r.Values(child)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ApplyVariables(child, vals)
+	if child.MaxWidth == nil || *child.MaxWidth != 8 {
+		t.Fatalf("maxWidth = %v, want 8", child.MaxWidth)
+	}
+
+	// Resizing the parent would stretch the child to 24 wide.
+	res, err := n.ResizeBounds(120, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Rectangle{X: 10, Y: 10, Width: 8, Height: 30}); res["1:2"] != want {
+		t.Errorf("child bounds = %+v, want %+v", res["1:2"], want)
+	}
+}